@@ -1,43 +1,127 @@
 package gograph
 
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopologyOptionFunc represent an alias of function type that
+// modifies the specified topological sort options.
+type TopologyOptionFunc[T comparable] func(options *TopologyOptions[T])
+
+// TopologyOptions represents the options of the topological sort. They
+// decide which vertex comes first, when several vertices are ready at
+// the same time.
+type TopologyOptions[T comparable] struct {
+	less     func(a, b *Vertex[T]) bool
+	reversed bool
+}
+
+// WithReversedEdges returns a TopologyOptionFunc that sorts the graph
+// as if all its edges were reversed, so each vertex comes after all the
+// vertices that it has an edge to, e.g. the dependencies of a package
+// come before the package.
+func WithReversedEdges[T comparable]() TopologyOptionFunc[T] {
+	return func(options *TopologyOptions[T]) {
+		options.reversed = true
+	}
+}
+
+// WithInsertionOrder returns a TopologyOptionFunc that always picks the
+// ready vertex that was added to the graph first. The result is the
+// lexicographically smallest topological order by insertion order.
+func WithInsertionOrder[T comparable]() TopologyOptionFunc[T] {
+	return func(options *TopologyOptions[T]) {
+		options.less = func(a, b *Vertex[T]) bool {
+			return a.sequence < b.sequence
+		}
+	}
+}
+
+// WithLabelOrder returns a TopologyOptionFunc that always picks the ready
+// vertex with the smallest label, according to the compare function. The
+// compare function returns a negative number if a < b, zero if a == b,
+// and a positive number if a > b, like cmp.Compare. Equal labels are
+// ordered by insertion order.
+func WithLabelOrder[T comparable](compare func(a, b T) int) TopologyOptionFunc[T] {
+	return func(options *TopologyOptions[T]) {
+		options.less = func(a, b *Vertex[T]) bool {
+			if c := compare(a.label, b.label); c != 0 {
+				return c < 0
+			}
+
+			return a.sequence < b.sequence
+		}
+	}
+}
+
+// WithPriority returns a TopologyOptionFunc that always picks the ready
+// vertex with the highest priority. Vertices with the same priority are
+// ordered by insertion order.
+func WithPriority[T comparable](priority func(v *Vertex[T]) float64) TopologyOptionFunc[T] {
+	return func(options *TopologyOptions[T]) {
+		options.less = func(a, b *Vertex[T]) bool {
+			pa, pb := priority(a), priority(b)
+			if pa != pb {
+				return pa > pb
+			}
+
+			return a.sequence < b.sequence
+		}
+	}
+}
+
+func newTopologyOptions[T comparable](options ...TopologyOptionFunc[T]) *TopologyOptions[T] {
+	opts := &TopologyOptions[T]{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
+
 // TopologySort performs a topological sort of the graph using
 // Kahn's algorithm. If the sorted list of vertices does not contain
 // all vertices in the graph, it means there is a cycle in the graph.
 //
+// By default, the vertices without incoming edges are queued in the
+// insertion order, and the other vertices are queued as soon as all
+// their predecessors are sorted, so the result is the same in every
+// run. The options, such as WithLabelOrder, choose the next vertex
+// among all the ready vertices instead.
+//
 // It returns error if it finds a cycle in the graph.
-func TopologySort[T comparable](g Graph[T]) ([]*Vertex[T], error) {
+func TopologySort[T comparable](g Graph[T], options ...TopologyOptionFunc[T]) ([]*Vertex[T], error) {
+	opts := newTopologyOptions(options...)
+
 	// Initialize a map to store the inDegree of each vertex
-	inDegrees := make(map[*Vertex[T]]int)
-	vertices := g.GetAllVertices()
-	for _, v := range vertices {
-		inDegrees[v] = v.inDegree
-	}
+	vertices := insertionOrder(g)
+	successors, inDegrees := topologyEdges(g, vertices, opts.reversed)
 
 	// Initialize a queue with vertices of inDegrees zero
-	queue := make([]*Vertex[T], 0)
-	for v, inDegree := range inDegrees {
-		if inDegree == 0 {
-			queue = append(queue, v)
+	queue := &topologyQueue[T]{less: opts.less}
+	for _, v := range vertices {
+		if inDegrees[v] == 0 {
+			queue.push(v)
 		}
 	}
 
 	// Initialize the sorted list of vertices
-	sortedVertices := make([]*Vertex[T], 0)
+	sortedVertices := make([]*Vertex[T], 0, len(vertices))
 
 	// Loop through the vertices with inDegree zero
-	for len(queue) > 0 {
+	for queue.Len() > 0 {
 		// Get the next vertex with inDegree zero
-		curr := queue[0]
-		queue = queue[1:]
+		curr := queue.pop()
 
 		// Add the vertex to the sorted list
 		sortedVertices = append(sortedVertices, curr)
 
 		// Decrement the inDegree of each of the vertex's neighbors
-		for _, neighbor := range curr.neighbors {
+		for _, neighbor := range successors(curr) {
 			inDegrees[neighbor]--
 			if inDegrees[neighbor] == 0 {
-				queue = append(queue, neighbor)
+				queue.push(neighbor)
 			}
 		}
 	}
@@ -49,3 +133,186 @@ func TopologySort[T comparable](g Graph[T]) ([]*Vertex[T], error) {
 
 	return sortedVertices, nil
 }
+
+// Layer is a set of vertices of a directed acyclic graph, such that
+// there is no path between any two of them. The vertices of a layer
+// can be processed in parallel, once the previous layers are done.
+type Layer[T comparable] struct {
+	Vertices []*Vertex[T]
+}
+
+// Width returns the number of vertices in the layer.
+func (l *Layer[T]) Width() int {
+	return len(l.Vertices)
+}
+
+// Layers groups the vertices of the graph into layers. The first layer
+// contains the vertices without incoming edges, and each vertex is in
+// the layer that follows its last predecessor, so the layer index is
+// the length of the longest path that ends at the vertex. The number
+// of layers is the minimum number of parallel steps to process the
+// graph.
+//
+// The vertices of each layer are in the insertion order, or in the
+// order of the options, such as WithLabelOrder. With WithReversedEdges,
+// the first layer contains the vertices without outgoing edges.
+//
+// It returns ErrDAGHasCycle if the graph has a cycle.
+func Layers[T comparable](g Graph[T], options ...TopologyOptionFunc[T]) ([]*Layer[T], error) {
+	opts := newTopologyOptions(options...)
+	if opts.less == nil {
+		WithInsertionOrder[T]()(opts)
+	}
+
+	vertices := insertionOrder(g)
+	successors, inDegrees := topologyEdges(g, vertices, opts.reversed)
+	current := make([]*Vertex[T], 0)
+	for _, v := range vertices {
+		if inDegrees[v] == 0 {
+			current = append(current, v)
+		}
+	}
+
+	layers := make([]*Layer[T], 0)
+	count := 0
+	for len(current) > 0 {
+		sort.SliceStable(current, func(i, j int) bool {
+			return opts.less(current[i], current[j])
+		})
+
+		layers = append(layers, &Layer[T]{Vertices: current})
+		count += len(current)
+
+		next := make([]*Vertex[T], 0)
+		for _, v := range current {
+			for _, neighbor := range successors(v) {
+				inDegrees[neighbor]--
+				if inDegrees[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+
+		current = next
+	}
+
+	if count != len(vertices) {
+		return nil, ErrDAGHasCycle
+	}
+
+	return layers, nil
+}
+
+// reaches returns true if there is a path from the source vertex to the
+// target vertex. It runs a depth-first search, which only visits the
+// vertices that the source reaches, without sorting them, so checking a
+// new edge of an acyclic graph takes O(V + E) time at most.
+func reaches[T comparable](source, target *Vertex[T]) bool {
+	visited := map[*Vertex[T]]bool{source: true}
+	stack := []*Vertex[T]{source}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if v == target {
+			return true
+		}
+
+		for _, neighbor := range v.neighbors {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+
+	return false
+}
+
+// insertionOrder returns the vertices of the graph in the order that
+// they were added to the graph.
+func insertionOrder[T comparable](g Graph[T]) []*Vertex[T] {
+	vertices := g.GetAllVertices()
+	sort.Slice(vertices, func(i, j int) bool {
+		return vertices[i].sequence < vertices[j].sequence
+	})
+
+	return vertices
+}
+
+// topologyEdges returns the successors and the in-degrees of the
+// vertices, following the edges or the reversed edges. The reversed
+// successors are in the insertion order.
+func topologyEdges[T comparable](
+	g Graph[T],
+	vertices []*Vertex[T],
+	reversed bool,
+) (func(v *Vertex[T]) []*Vertex[T], map[*Vertex[T]]int) {
+	inDegrees := make(map[*Vertex[T]]int, len(vertices))
+	if !reversed || !g.IsDirected() {
+		for _, v := range vertices {
+			inDegrees[v] = v.inDegree
+		}
+
+		return func(v *Vertex[T]) []*Vertex[T] { return v.neighbors }, inDegrees
+	}
+
+	predecessors := make(map[*Vertex[T]][]*Vertex[T], len(vertices))
+	for _, v := range vertices {
+		inDegrees[v] = len(v.neighbors)
+		for _, neighbor := range v.neighbors {
+			predecessors[neighbor] = append(predecessors[neighbor], v)
+		}
+	}
+
+	return func(v *Vertex[T]) []*Vertex[T] { return predecessors[v] }, inDegrees
+}
+
+// topologyQueue is the queue of the ready vertices of the topological
+// sort. It is a FIFO queue without the less function, and a heap that
+// pops the smallest vertex otherwise.
+type topologyQueue[T comparable] struct {
+	vertices []*Vertex[T]
+	less     func(a, b *Vertex[T]) bool
+}
+
+func (q *topologyQueue[T]) push(v *Vertex[T]) {
+	if q.less == nil {
+		q.vertices = append(q.vertices, v)
+		return
+	}
+
+	heap.Push(q, v)
+}
+
+func (q *topologyQueue[T]) pop() *Vertex[T] {
+	if q.less == nil {
+		v := q.vertices[0]
+		q.vertices = q.vertices[1:]
+		return v
+	}
+
+	return heap.Pop(q).(*Vertex[T])
+}
+
+func (q *topologyQueue[T]) Len() int {
+	return len(q.vertices)
+}
+
+func (q *topologyQueue[T]) Less(i, j int) bool {
+	return q.less(q.vertices[i], q.vertices[j])
+}
+
+func (q *topologyQueue[T]) Swap(i, j int) {
+	q.vertices[i], q.vertices[j] = q.vertices[j], q.vertices[i]
+}
+
+func (q *topologyQueue[T]) Push(x any) {
+	q.vertices = append(q.vertices, x.(*Vertex[T]))
+}
+
+func (q *topologyQueue[T]) Pop() any {
+	n := len(q.vertices)
+	v := q.vertices[n-1]
+	q.vertices = q.vertices[:n-1]
+	return v
+}
@@ -73,3 +73,166 @@ func TestTopologySort(t *testing.T) {
 		t.Errorf("unexpected sort order. Got %v, expected %v", sortedVertices, expectedOrder)
 	}
 }
+
+func labels[T comparable](vertices []*Vertex[T]) []T {
+	result := make([]T, len(vertices))
+	for i, v := range vertices {
+		result[i] = v.Label()
+	}
+
+	return result
+}
+
+func newTopologyTestGraph() Graph[string] {
+	// the vertices are added in the order d, b, c, a, e.
+	g := New[string](Acyclic())
+	for _, label := range []string{"d", "b", "c", "a", "e"} {
+		g.AddVertexByLabel(label)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("d"), g.GetVertexByID("e"))
+	_, _ = g.AddEdge(g.GetVertexByID("b"), g.GetVertexByID("a"))
+	_, _ = g.AddEdge(g.GetVertexByID("c"), g.GetVertexByID("a"))
+	_, _ = g.AddEdge(g.GetVertexByID("a"), g.GetVertexByID("e"))
+
+	return g
+}
+
+func TestTopologySort_Options(t *testing.T) {
+	g := newTopologyTestGraph()
+
+	compare := func(a, b string) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+
+		return 0
+	}
+
+	priority := map[string]float64{"a": 1, "b": 1, "c": 3, "d": 2, "e": 0}
+
+	tests := []struct {
+		name     string
+		options  []TopologyOptionFunc[string]
+		expected []string
+	}{
+		{"default", nil, []string{"d", "b", "c", "a", "e"}},
+		{"insertion order", []TopologyOptionFunc[string]{WithInsertionOrder[string]()}, []string{"d", "b", "c", "a", "e"}},
+		{"label order", []TopologyOptionFunc[string]{WithLabelOrder(compare)}, []string{"b", "c", "a", "d", "e"}},
+		{
+			"priority",
+			[]TopologyOptionFunc[string]{WithPriority(func(v *Vertex[string]) float64 { return priority[v.Label()] })},
+			[]string{"c", "d", "b", "a", "e"},
+		},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 10; i++ {
+			sorted, err := TopologySort(g, test.options...)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.name, err)
+			}
+
+			if got := labels(sorted); !reflect.DeepEqual(test.expected, got) {
+				t.Fatalf("%s: expected %v, but got %v", test.name, test.expected, got)
+			}
+		}
+	}
+}
+
+func TestTopologySort_Cycle(t *testing.T) {
+	g := New[int](Directed())
+	_, _ = g.AddEdge(NewVertex(1), NewVertex(2))
+	_, _ = g.AddEdge(NewVertex(2), NewVertex(1))
+
+	if _, err := TopologySort(g, WithInsertionOrder[int]()); err != ErrDAGHasCycle {
+		t.Errorf("expected error %v, but got %v", ErrDAGHasCycle, err)
+	}
+
+	if _, err := Layers(g); err != ErrDAGHasCycle {
+		t.Errorf("expected error %v, but got %v", ErrDAGHasCycle, err)
+	}
+}
+
+func TestLayers(t *testing.T) {
+	g := newTopologyTestGraph()
+
+	layers, err := Layers(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]string{{"d", "b", "c"}, {"a"}, {"e"}}
+	if len(layers) != len(expected) {
+		t.Fatalf("expected %d layers, but got %d", len(expected), len(layers))
+	}
+
+	for i, layer := range layers {
+		if got := labels(layer.Vertices); !reflect.DeepEqual(expected[i], got) {
+			t.Errorf("layer %d: expected %v, but got %v", i, expected[i], got)
+		}
+
+		if layer.Width() != len(expected[i]) {
+			t.Errorf("layer %d: expected width %d, but got %d", i, len(expected[i]), layer.Width())
+		}
+	}
+
+	layers, _ = Layers(g, WithLabelOrder(func(a, b string) int { return int(a[0]) - int(b[0]) }))
+	if got := labels(layers[0].Vertices); !reflect.DeepEqual([]string{"b", "c", "d"}, got) {
+		t.Errorf("expected the first layer in label order, but got %v", got)
+	}
+
+	empty, err := Layers(New[int](Directed()))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("expected no layers, but got %v, %v", empty, err)
+	}
+}
+
+func TestTopologySort_ReversedEdges(t *testing.T) {
+	g := newTopologyTestGraph()
+
+	sorted, err := TopologySort(g, WithReversedEdges[string]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := labels(sorted); !reflect.DeepEqual([]string{"e", "d", "a", "b", "c"}, got) {
+		t.Errorf("expected the reversed order, but got %v", got)
+	}
+
+	layers, err := Layers(g, WithReversedEdges[string]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]string{{"e"}, {"d", "a"}, {"b", "c"}}
+	for i, layer := range layers {
+		if got := labels(layer.Vertices); !reflect.DeepEqual(expected[i], got) {
+			t.Errorf("layer %d: expected %v, but got %v", i, expected[i], got)
+		}
+	}
+}
+
+func TestAcyclic_AddEdge(t *testing.T) {
+	g := New[int](Acyclic())
+
+	// a diamond has two paths between 1 and 4, but no cycle.
+	for _, edge := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}} {
+		if _, err := g.AddEdge(NewVertex(edge[0]), NewVertex(edge[1])); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	for _, edge := range [][2]int{{4, 1}, {3, 1}, {2, 2}} {
+		if _, err := g.AddEdge(g.GetVertexByID(edge[0]), g.GetVertexByID(edge[1])); err != ErrDAGCycle {
+			t.Errorf("expected error %s for the edge %v, but got %v", ErrDAGCycle, edge, err)
+		}
+	}
+
+	if g.Size() != 4 || g.GetVertexByID(4).OutDegree() != 0 || g.GetVertexByID(1).InDegree() != 0 {
+		t.Errorf("expected the rejected edges to be removed")
+	}
+}
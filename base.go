@@ -21,6 +21,10 @@ type baseGraph[T comparable] struct {
 
 	verticesCount uint32
 	edgesCount    uint32
+
+	// sequence is the number of vertices that have been added to the
+	// graph, which keeps the insertion order of the vertices.
+	sequence uint64
 }
 
 func newBaseGraph[T comparable](properties GraphProperties) *baseGraph[T] {
@@ -83,9 +87,8 @@ func (g *baseGraph[T]) AddEdge(from, to *Vertex[T], options ...EdgeOptionFunc) (
 
 	// prevent cycle creation, if graph is acyclic
 	if g.properties.isAcyclic {
-		// If topological sort returns an error, new edges created a cycle
-		_, err := TopologySort[T](g)
-		if err != nil {
+		// the new edge created a cycle, if it can be followed back to "from"
+		if reaches(to, from) {
 			// Remove the new edges
 			from.neighbors = from.neighbors[:len(from.neighbors)-1]
 			to.inDegree--
@@ -138,6 +141,8 @@ func (g *baseGraph[T]) addVertex(v *Vertex[T]) *Vertex[T] {
 		return nil
 	}
 
+	g.sequence++
+	v.sequence = g.sequence
 	g.vertices[v.label] = v
 	atomic.AddUint32(&g.verticesCount, 1)
 
@@ -0,0 +1,452 @@
+package gograph
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+var (
+	ErrInvalidBinary      = errors.New("invalid binary graph data")
+	ErrUnsupportedVersion = errors.New("unsupported binary graph version")
+	ErrChecksumMismatch   = errors.New("binary graph checksum mismatch")
+)
+
+var (
+	binaryMagic         = [4]byte{'G', 'O', 'G', 'R'}
+	binaryChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+const (
+	// binaryVersion is the current version of the binary graph format.
+	// ReadBinary rejects data with a greater version.
+	binaryVersion = 1
+
+	binaryMinVertexBytes   = 1 // length prefix of an empty label
+	binaryMinNeighborBytes = 1 // a single byte varint
+)
+
+// flags of the binary format header.
+const (
+	binaryFlagDirected byte = 1 << iota
+	binaryFlagWeighted
+	binaryFlagAcyclic
+	binaryFlagVertexWeights
+	binaryFlagEdgeWeights
+	binaryFlagChecksum
+)
+
+// BinaryOptionFunc represent an alias of function type that
+// modifies the specified binary encoding options.
+type BinaryOptionFunc func(options *BinaryOptions)
+
+// BinaryOptions represents the options of the binary encoding.
+type BinaryOptions struct {
+	checksum bool
+}
+
+// WithChecksum returns a BinaryOptionFunc that appends a CRC-32C checksum
+// of the encoded data, which is verified by ReadBinary.
+func WithChecksum() BinaryOptionFunc {
+	return func(options *BinaryOptions) {
+		options.checksum = true
+	}
+}
+
+// WriteBinary writes the graph to w in a compact, versioned binary format
+// that can be loaded with ReadBinary. The labels are encoded with the
+// specified codec.
+//
+// The format consists of:
+//
+//  1. A header with a magic number, the format version and a flags byte
+//     that stores the graph type and the optional sections.
+//  2. The vertices: a varint count, followed by the length-prefixed label
+//     of each vertex, and its weight, if any vertex has a non-zero weight.
+//  3. The adjacency list: for each vertex, in the same order, a varint
+//     out-degree followed by the varint-encoded delta of each neighbor
+//     position, and the edge weight, if any edge has a non-zero weight.
+//  4. An optional CRC-32C checksum of all the preceding bytes.
+//
+// The vertices are written in their insertion order, so the same graph
+// always gives the same bytes, and the loaded graph keeps the insertion
+// order that TopologySort uses. The order of the neighbors is preserved,
+// so the traversal order of the loaded graph is the same as the original
+// one. Metadata of vertices and
+// edges is not stored.
+func WriteBinary[T comparable](w io.Writer, g Graph[T], codec LabelCodec[T], options ...BinaryOptionFunc) error {
+	var opts BinaryOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	vertices := insertionOrder(g)
+	positions := make(map[T]int, len(vertices))
+
+	flags := binaryFlags(g, opts)
+	for i, v := range vertices {
+		positions[v.label] = i
+
+		if v.properties.weight != 0 {
+			flags |= binaryFlagVertexWeights
+		}
+
+		for _, neighbor := range v.neighbors {
+			if edge := g.GetEdge(v, neighbor); edge != nil && edge.Weight() != 0 {
+				flags |= binaryFlagEdgeWeights
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	hash := crc32.New(binaryChecksumTable)
+
+	var out io.Writer = bw
+	if opts.checksum {
+		out = io.MultiWriter(bw, hash)
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, binaryMagic[:]...)
+	buf = append(buf, binaryVersion, flags)
+	buf = binary.AppendUvarint(buf, uint64(len(vertices)))
+
+	var err error
+	var label []byte
+	for _, v := range vertices {
+		label, err = codec.AppendLabel(label[:0], v.label)
+		if err != nil {
+			return err
+		}
+
+		buf = binary.AppendUvarint(buf, uint64(len(label)))
+		buf = append(buf, label...)
+		if flags&binaryFlagVertexWeights != 0 {
+			buf = appendFloat64(buf, v.properties.weight)
+		}
+
+		if buf, err = flushBuffer(out, buf); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range vertices {
+		buf = binary.AppendUvarint(buf, uint64(len(v.neighbors)))
+
+		prev := 0
+		for _, neighbor := range v.neighbors {
+			pos := positions[neighbor.label]
+			buf = binary.AppendVarint(buf, int64(pos-prev))
+			prev = pos
+
+			if flags&binaryFlagEdgeWeights != 0 {
+				var weight float64
+				if edge := g.GetEdge(v, neighbor); edge != nil {
+					weight = edge.Weight()
+				}
+
+				buf = appendFloat64(buf, weight)
+			}
+		}
+
+		if buf, err = flushBuffer(out, buf); err != nil {
+			return err
+		}
+	}
+
+	if _, err = out.Write(buf); err != nil {
+		return err
+	}
+
+	if opts.checksum {
+		if _, err = bw.Write(binary.LittleEndian.AppendUint32(nil, hash.Sum32())); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ReadBinary loads a graph that was written by WriteBinary. The labels are
+// decoded with the specified codec, which must match the one used for
+// writing.
+//
+// Unlike building the graph with AddEdge, the loader pre-sizes the internal
+// maps of the graph, and checks acyclic graphs for cycles only once after
+// all the edges are loaded.
+//
+// Corrupt or truncated input returns an error that wraps ErrInvalidBinary.
+// If the data was written with a newer version of the format, it returns
+// ErrUnsupportedVersion, and if the checksum doesn't match the data, it
+// returns ErrChecksumMismatch.
+func ReadBinary[T comparable](r io.Reader, codec LabelCodec[T]) (Graph[T], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < len(binaryMagic)+2 || [4]byte(data[:4]) != binaryMagic {
+		return nil, invalidBinary("missing header")
+	}
+
+	version, flags := data[4], data[5]
+	if version == 0 || version > binaryVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	if flags&binaryFlagChecksum != 0 {
+		if len(data) < 10 {
+			return nil, invalidBinary("missing checksum")
+		}
+
+		body := data[:len(data)-4]
+		if crc32.Checksum(body, binaryChecksumTable) != binary.LittleEndian.Uint32(data[len(data)-4:]) {
+			return nil, ErrChecksumMismatch
+		}
+
+		data = body
+	}
+
+	dec := &binaryDecoder{data: data, off: 6}
+	g := newBaseGraph[T](GraphProperties{
+		isDirected: flags&binaryFlagDirected != 0,
+		isWeighted: flags&binaryFlagWeighted != 0,
+		isAcyclic:  flags&binaryFlagAcyclic != 0,
+	})
+
+	count := dec.count(binaryMinVertexBytes)
+	g.vertices = make(map[T]*Vertex[T], count)
+	g.edges = make(map[T]map[T]*Edge[T], count)
+
+	vertices := make([]*Vertex[T], 0, count)
+	for i := 0; i < count && dec.err == nil; i++ {
+		raw := dec.bytes(dec.count(1))
+		if dec.err != nil {
+			break
+		}
+
+		var label T
+		label, err = codec.DecodeLabel(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBinary, err)
+		}
+
+		v := &Vertex[T]{label: label}
+		if flags&binaryFlagVertexWeights != 0 {
+			v.properties.weight = dec.float64()
+		}
+
+		if g.addVertex(v) == nil {
+			return nil, invalidBinary("duplicate label %v", label)
+		}
+
+		vertices = append(vertices, v)
+	}
+
+	for _, v := range vertices {
+		degree := dec.count(binaryMinNeighborBytes)
+		if dec.err != nil {
+			break
+		}
+
+		if degree == 0 {
+			continue
+		}
+
+		v.neighbors = make([]*Vertex[T], 0, degree)
+		destMap := make(map[T]*Edge[T], degree)
+		g.edges[v.label] = destMap
+
+		pos := 0
+		for j := 0; j < degree && dec.err == nil; j++ {
+			pos += dec.varint()
+
+			var weight float64
+			if flags&binaryFlagEdgeWeights != 0 {
+				weight = dec.float64()
+			}
+
+			if dec.err != nil {
+				break
+			}
+
+			if pos < 0 || pos >= len(vertices) {
+				return nil, invalidBinary("neighbor position %d out of range", pos)
+			}
+
+			dest := vertices[pos]
+			if _, ok := destMap[dest.label]; ok && (g.properties.isDirected || dest != v) {
+				return nil, invalidBinary("duplicate edge %v -> %v", v.label, dest.label)
+			}
+
+			v.neighbors = append(v.neighbors, dest)
+			dest.inDegree++
+			destMap[dest.label] = &Edge[T]{
+				source:     v,
+				dest:       dest,
+				properties: EdgeProperties{weight: weight},
+			}
+			g.edgesCount++
+		}
+	}
+
+	if dec.err != nil {
+		return nil, dec.err
+	}
+
+	if dec.off != len(dec.data) {
+		return nil, invalidBinary("%d trailing bytes", len(dec.data)-dec.off)
+	}
+
+	if err = g.validateLoaded(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// validateLoaded checks the invariants of the graph type that the binary
+// loader cannot check edge by edge.
+func (g *baseGraph[T]) validateLoaded() error {
+	if !g.properties.isDirected {
+		for source, destMap := range g.edges {
+			for dest := range destMap {
+				if _, ok := g.edges[dest][source]; !ok {
+					return invalidBinary("undirected edge %v - %v is not symmetric", source, dest)
+				}
+			}
+		}
+	}
+
+	if g.properties.isAcyclic {
+		if _, err := TopologySort[T](g); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidBinary, err)
+		}
+	}
+
+	return nil
+}
+
+func binaryFlags[T comparable](g Graph[T], opts BinaryOptions) byte {
+	var flags byte
+	if g.IsDirected() {
+		flags |= binaryFlagDirected
+	}
+
+	if g.IsWeighted() {
+		flags |= binaryFlagWeighted
+	}
+
+	if g.IsAcyclic() {
+		flags |= binaryFlagAcyclic
+	}
+
+	if opts.checksum {
+		flags |= binaryFlagChecksum
+	}
+
+	return flags
+}
+
+// flushBuffer writes the buffer once it is large enough, and returns the
+// emptied buffer to be reused.
+func flushBuffer(w io.Writer, buf []byte) ([]byte, error) {
+	if len(buf) < 4096 {
+		return buf, nil
+	}
+
+	_, err := w.Write(buf)
+	return buf[:0], err
+}
+
+func appendFloat64(dst []byte, f float64) []byte {
+	return binary.LittleEndian.AppendUint64(dst, math.Float64bits(f))
+}
+
+// binaryDecoder reads the primitives of the binary format. The first
+// error is kept in err, and all the following reads return zero values.
+type binaryDecoder struct {
+	data []byte
+	off  int
+	err  error
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.data[d.off:])
+	if n <= 0 {
+		d.err = invalidBinary("truncated or malformed varint at offset %d", d.off)
+		return 0
+	}
+
+	d.off += n
+	return v
+}
+
+func (d *binaryDecoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(d.data[d.off:])
+	if n <= 0 || v > math.MaxInt32 || v < math.MinInt32 {
+		d.err = invalidBinary("truncated or malformed varint at offset %d", d.off)
+		return 0
+	}
+
+	d.off += n
+	return int(v)
+}
+
+// count reads a non-negative count, and verifies that the remaining data
+// is large enough to hold count items of at least minBytes each. It
+// prevents huge allocations for corrupt counts.
+func (d *binaryDecoder) count(minBytes int) int {
+	v := d.uvarint()
+	if d.err != nil {
+		return 0
+	}
+
+	if v > uint64(len(d.data)-d.off)/uint64(minBytes) {
+		d.err = invalidBinary("count %d exceeds the remaining data", v)
+		return 0
+	}
+
+	return int(v)
+}
+
+func (d *binaryDecoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+
+	out := d.data[d.off : d.off+n]
+	d.off += n
+	return out
+}
+
+func (d *binaryDecoder) float64() float64 {
+	if d.err != nil {
+		return 0
+	}
+
+	if len(d.data)-d.off < 8 {
+		d.err = invalidBinary("truncated float at offset %d", d.off)
+		return 0
+	}
+
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data[d.off:]))
+	d.off += 8
+	return v
+}
+
+func invalidBinary(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidBinary, fmt.Sprintf(format, args...))
+}
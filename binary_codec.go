@@ -0,0 +1,58 @@
+package gograph
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var ErrInvalidLabel = errors.New("invalid encoded label")
+
+// LabelCodec converts vertex labels of type T to bytes and back. It is
+// used by WriteBinary and ReadBinary to store the labels, which are the
+// only part of the graph that the binary format cannot encode by itself.
+//
+// The length of each encoded label is stored by the binary format, so
+// DecodeLabel always receives exactly the bytes that were appended by
+// AppendLabel.
+type LabelCodec[T comparable] interface {
+	// AppendLabel appends the encoded form of the label to dst and
+	// returns the extended slice.
+	AppendLabel(dst []byte, label T) ([]byte, error)
+
+	// DecodeLabel decodes a label that was encoded by AppendLabel.
+	DecodeLabel(src []byte) (T, error)
+}
+
+// StringCodec is a LabelCodec for string labels. It stores the raw bytes
+// of the string.
+type StringCodec struct{}
+
+// AppendLabel appends the bytes of the label to dst.
+func (StringCodec) AppendLabel(dst []byte, label string) ([]byte, error) {
+	return append(dst, label...), nil
+}
+
+// DecodeLabel returns the input bytes as a string.
+func (StringCodec) DecodeLabel(src []byte) (string, error) {
+	return string(src), nil
+}
+
+// IntCodec is a LabelCodec for int labels. It stores the labels as
+// zig-zag encoded varints, so small labels take a single byte.
+type IntCodec struct{}
+
+// AppendLabel appends the varint encoded label to dst.
+func (IntCodec) AppendLabel(dst []byte, label int) ([]byte, error) {
+	return binary.AppendVarint(dst, int64(label)), nil
+}
+
+// DecodeLabel decodes a varint encoded label. It returns ErrInvalidLabel
+// if the input is not a single valid varint.
+func (IntCodec) DecodeLabel(src []byte) (int, error) {
+	label, n := binary.Varint(src)
+	if n <= 0 || n != len(src) {
+		return 0, ErrInvalidLabel
+	}
+
+	return int(label), nil
+}
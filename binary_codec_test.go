@@ -0,0 +1,56 @@
+package gograph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStringCodec(t *testing.T) {
+	var codec StringCodec
+
+	buf, err := codec.AppendLabel([]byte("x"), "label")
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if string(buf) != "xlabel" {
+		t.Errorf(testErrMsgNotEqual, "xlabel", string(buf))
+	}
+
+	label, err := codec.DecodeLabel(buf[1:])
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if label != "label" {
+		t.Errorf(testErrMsgNotEqual, "label", label)
+	}
+}
+
+func TestIntCodec(t *testing.T) {
+	var codec IntCodec
+
+	for _, expected := range []int{0, 1, -1, 300, -70000, 1 << 40} {
+		buf, err := codec.AppendLabel(nil, expected)
+		if err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		label, err := codec.DecodeLabel(buf)
+		if err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if label != expected {
+			t.Errorf(testErrMsgNotEqual, expected, label)
+		}
+	}
+
+	if _, err := codec.DecodeLabel(nil); !errors.Is(err, ErrInvalidLabel) {
+		t.Errorf(testErrMsgNotEqual, ErrInvalidLabel, err)
+	}
+
+	if _, err := codec.DecodeLabel([]byte{1, 2}); !errors.Is(err, ErrInvalidLabel) {
+		t.Errorf(testErrMsgNotEqual, ErrInvalidLabel, err)
+	}
+}
@@ -0,0 +1,213 @@
+package gograph
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func initBinaryTestGraph(options ...GraphOptionFunc) Graph[string] {
+	g := New[string](options...)
+	vA := g.AddVertexByLabel("A", WithVertexWeight(2))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, WithEdgeWeight(1.5))
+	_, _ = g.AddEdge(vA, vC, WithEdgeWeight(-3))
+	_, _ = g.AddEdge(vB, vD, WithEdgeWeight(4))
+	_, _ = g.AddEdge(vC, vD)
+	return g
+}
+
+func assertSameGraph(t *testing.T, expected, actual Graph[string]) {
+	t.Helper()
+
+	if expected.IsDirected() != actual.IsDirected() ||
+		expected.IsWeighted() != actual.IsWeighted() ||
+		expected.IsAcyclic() != actual.IsAcyclic() {
+		t.Errorf("Expected same graph type")
+	}
+
+	if expected.Order() != actual.Order() {
+		t.Errorf(testErrMsgNotEqual, expected.Order(), actual.Order())
+	}
+
+	if expected.Size() != actual.Size() {
+		t.Errorf(testErrMsgNotEqual, expected.Size(), actual.Size())
+	}
+
+	for _, v := range expected.GetAllVertices() {
+		loaded := actual.GetVertexByID(v.Label())
+		if loaded == nil {
+			t.Fatalf("Expected vertex %s to exist", v.Label())
+		}
+
+		if loaded.Weight() != v.Weight() || loaded.InDegree() != v.InDegree() || loaded.OutDegree() != v.OutDegree() {
+			t.Errorf(testErrMsgNotEqual, v, loaded)
+		}
+
+		// order of the neighbors must be preserved
+		for i, neighbor := range v.neighbors {
+			if loaded.neighbors[i].Label() != neighbor.Label() {
+				t.Errorf(testErrMsgNotEqual, neighbor.Label(), loaded.neighbors[i].Label())
+			}
+		}
+	}
+
+	for _, edge := range expected.AllEdges() {
+		loaded := actual.GetEdge(actual.GetVertexByID(edge.Source().Label()), actual.GetVertexByID(edge.Destination().Label()))
+		if loaded == nil || loaded.Weight() != edge.Weight() {
+			t.Errorf(testErrMsgNotEqual, edge, loaded)
+		}
+	}
+}
+
+func TestBinary_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []GraphOptionFunc
+	}{
+		{name: "undirected"},
+		{name: "directed", options: []GraphOptionFunc{Directed(), Weighted()}},
+		{name: "acyclic", options: []GraphOptionFunc{Acyclic()}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := initBinaryTestGraph(tc.options...)
+
+			for _, options := range [][]BinaryOptionFunc{nil, {WithChecksum()}} {
+				var buf bytes.Buffer
+				if err := WriteBinary[string](&buf, g, StringCodec{}, options...); err != nil {
+					t.Fatalf(testErrMsgError, err)
+				}
+
+				loaded, err := ReadBinary[string](&buf, StringCodec{})
+				if err != nil {
+					t.Fatalf(testErrMsgError, err)
+				}
+
+				assertSameGraph(t, g, loaded)
+			}
+		})
+	}
+}
+
+func TestBinary_LoadedGraphIsUsable(t *testing.T) {
+	g := New[int](Acyclic())
+	for i := 0; i < 100; i++ {
+		_, _ = g.AddEdge(NewVertex(i), NewVertex(i+1))
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBinary[int](&buf, g, IntCodec{}); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	loaded, err := ReadBinary[int](&buf, IntCodec{})
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	_, err = loaded.AddEdge(loaded.GetVertexByID(100), loaded.GetVertexByID(0))
+	if !errors.Is(err, ErrDAGCycle) {
+		t.Errorf(testErrMsgNotEqual, ErrDAGCycle, err)
+	}
+
+	loaded.RemoveEdges(loaded.GetEdge(loaded.GetVertexByID(0), loaded.GetVertexByID(1)))
+	if loaded.Size() != 99 {
+		t.Errorf(testErrMsgNotEqual, 99, loaded.Size())
+	}
+}
+
+func TestBinary_InsertionOrder(t *testing.T) {
+	g := newTopologyTestGraph()
+
+	var first, second bytes.Buffer
+	if err := WriteBinary[string](&first, g, StringCodec{}); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if err := WriteBinary[string](&second, g, StringCodec{}); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Errorf("expected the same bytes for the same graph")
+	}
+
+	loaded, err := ReadBinary[string](&first, StringCodec{})
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	sorted, err := TopologySort(loaded)
+	if err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	var labels []string
+	for _, v := range sorted {
+		labels = append(labels, v.Label())
+	}
+
+	expected := []string{"d", "b", "c", "a", "e"}
+	if !reflect.DeepEqual(expected, labels) {
+		t.Errorf(testErrMsgNotEqual, expected, labels)
+	}
+}
+
+func TestBinary_Corrupt(t *testing.T) {
+	g := initBinaryTestGraph(Directed(), Weighted())
+
+	var buf bytes.Buffer
+	if err := WriteBinary[string](&buf, g, StringCodec{}); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+	data := buf.Bytes()
+
+	// every truncation must fail without panicking
+	for i := 0; i < len(data); i++ {
+		if _, err := ReadBinary[string](bytes.NewReader(data[:i]), StringCodec{}); !errors.Is(err, ErrInvalidBinary) {
+			t.Errorf("Expected ErrInvalidBinary for %d bytes, but got %v", i, err)
+		}
+	}
+
+	// flipping bytes must fail or load a graph without panicking
+	for i := 6; i < len(data); i++ {
+		corrupt := append([]byte(nil), data...)
+		corrupt[i] ^= 0xff
+		_, _ = ReadBinary[string](bytes.NewReader(corrupt), StringCodec{})
+	}
+
+	// trailing data
+	_, err := ReadBinary[string](bytes.NewReader(append(append([]byte(nil), data...), 0)), StringCodec{})
+	if !errors.Is(err, ErrInvalidBinary) {
+		t.Errorf(testErrMsgNotEqual, ErrInvalidBinary, err)
+	}
+
+	unsupported := append([]byte(nil), data...)
+	unsupported[4] = binaryVersion + 1
+	if _, err = ReadBinary[string](bytes.NewReader(unsupported), StringCodec{}); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf(testErrMsgNotEqual, ErrUnsupportedVersion, err)
+	}
+}
+
+func TestBinary_Checksum(t *testing.T) {
+	g := initBinaryTestGraph()
+
+	var buf bytes.Buffer
+	if err := WriteBinary[string](&buf, g, StringCodec{}, WithChecksum()); err != nil {
+		t.Fatalf(testErrMsgError, err)
+	}
+
+	data := buf.Bytes()
+	data[len(data)/2] ^= 0x01
+
+	if _, err := ReadBinary[string](bytes.NewReader(data), StringCodec{}); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf(testErrMsgNotEqual, ErrChecksumMismatch, err)
+	}
+}
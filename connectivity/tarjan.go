@@ -1,6 +1,9 @@
 package connectivity
 
-import "github.com/hmdsefi/gograph"
+import (
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/traverse"
+)
 
 // Tarjan's algorithm is based on depth-first search and is widely used for
 // finding strongly connected components in a graph. The algorithm is efficient
@@ -22,98 +25,106 @@ func newTarjanVertex[T comparable](vertex *gograph.Vertex[T]) *tarjanVertex[T] {
 	}
 }
 
+// tarjanSCCS keeps the state of the algorithm between the depth-first
+// visit events.
 type tarjanSCCS[T comparable] struct {
-	vertices map[T]*tarjanVertex[T]
+	graph   gograph.Graph[T]
+	index   map[T]int // the order in which a vertex is visited during the DFS search.
+	lowLink map[T]int // the minimum index of any vertex reachable from the vertex during the search.
+	parent  map[T]T   // the parent of each vertex in the depth-first tree.
+	onStack map[T]bool
+	stack   []*gograph.Vertex[T]
+	sccs    [][]*gograph.Vertex[T]
 }
 
-func newTarjanSCCS[T comparable](vertices map[T]*tarjanVertex[T]) *tarjanSCCS[T] {
-	return &tarjanSCCS[T]{vertices: vertices}
+func newTarjanSCCS[T comparable](g gograph.Graph[T]) *tarjanSCCS[T] {
+	return &tarjanSCCS[T]{
+		graph:   g,
+		index:   make(map[T]int),
+		lowLink: make(map[T]int),
+		parent:  make(map[T]T),
+		onStack: make(map[T]bool),
+	}
 }
 
-// Tarjan is the entry point to the algorithm. It initializes the index,
-// stack, and sccs variables and then loops through all the vertices in
-// the graph. It returns a slice of vertices' slice, where each inner
-// slice represents a strongly connected component of the graph.
+// Tarjan is the entry point to the algorithm. It visits all the vertices
+// of the graph with traverse.DepthFirstVisitAll, which is iterative, so
+// large graphs don't overflow the call stack. It returns a slice of
+// vertices' slice, where each inner slice represents a strongly connected
+// component of the graph.
 func Tarjan[T comparable](g gograph.Graph[T]) [][]*gograph.Vertex[T] {
-	var (
-		index     int
-		stack     []*tarjanVertex[T]
-		tvertices = make(map[T]*tarjanVertex[T])
-		sccs      [][]*tarjanVertex[T]
-	)
-
-	vertices := g.GetAllVertices()
-
-	for _, v := range vertices {
-		tv := newTarjanVertex(v)
-		tvertices[tv.Label()] = tv
+	tarj := newTarjanSCCS(g)
+
+	_, _ = traverse.DepthFirstVisitAll(g, &traverse.DFSVisitor[T]{
+		DiscoverVertex: tarj.discover,
+		FinishVertex:   tarj.finish,
+		TreeEdge: func(e *gograph.Edge[T]) error {
+			tarj.parent[e.Destination().Label()] = e.Source().Label()
+			return nil
+		},
+		BackEdge:    tarj.update,
+		ForwardEdge: tarj.update,
+		CrossEdge:   tarj.update,
+	})
+
+	return tarj.sccs
+}
+
+// discover sets the index and lowLink values of the vertex, and adds it
+// to the stack.
+func (t *tarjanSCCS[T]) discover(v *gograph.Vertex[T], time int) error {
+	t.index[v.Label()] = time
+	t.lowLink[v.Label()] = time
+	t.stack = append(t.stack, v)
+	t.onStack[v.Label()] = true
+	return nil
+}
+
+// update lowers the lowLink value of the edge source, if the destination
+// has already been visited and is still on the stack.
+func (t *tarjanSCCS[T]) update(e *gograph.Edge[T]) error {
+	if t.onStack[e.Destination().Label()] {
+		source := e.Source().Label()
+		t.lowLink[source] = min(t.lowLink[source], t.index[e.Destination().Label()])
 	}
 
-	tarj := newTarjanSCCS(tvertices)
+	return nil
+}
 
-	for _, v := range tvertices {
-		if v.index < 0 {
-			tarj.visit(v, &index, &stack, &sccs)
-		}
+// finish pops a strongly connected component from the stack if the vertex
+// is its root, and passes the lowLink value to the parent.
+//
+// The depth-first visit reports each undirected edge once, so the lowLink
+// values can't follow an undirected edge back. In undirected graphs, where
+// the strongly connected components are the connected components, a
+// component is popped at the root of each depth-first tree instead.
+func (t *tarjanSCCS[T]) finish(v *gograph.Vertex[T], _ int) error {
+	label := v.Label()
+	parent, hasParent := t.parent[label]
+	if hasParent {
+		t.lowLink[parent] = min(t.lowLink[parent], t.lowLink[label])
 	}
 
-	result := make([][]*gograph.Vertex[T], len(sccs))
-	for i, list := range sccs {
-		result[i] = make([]*gograph.Vertex[T], len(list))
-		for j := range list {
-			result[i][j] = list[j].Vertex
-		}
+	isRoot := t.lowLink[label] == t.index[label]
+	if !t.graph.IsDirected() {
+		isRoot = !hasParent
 	}
-	return result
-}
 
-// visit updates the index and lowLink values of the vertex, adds it to
-// the stack, and recursively calls itself on each of its neighbors. If
-// a neighbor has not been visited before, its index and lowLink values
-// are updated, and the recursion continues. If a neighbor has already
-// been visited and is still on the stack, its lowLink value is updated.
-func (t *tarjanSCCS[T]) visit(
-	v *tarjanVertex[T],
-	index *int,
-	stack *[]*tarjanVertex[T],
-	sccs *[][]*tarjanVertex[T],
-) {
-	v.index = *index
-	v.lowLink = *index
-	*index++
-	*stack = append(*stack, v)
-	v.onStack = true
-
-	neighbors := v.Neighbors()
-	for _, w := range neighbors {
-		tv := t.vertices[w.Label()]
-		if tv.index == -1 {
-			t.visit(tv, index, stack, sccs)
-			v.lowLink = min(v.lowLink, tv.lowLink)
-		} else if tv.onStack {
-			v.lowLink = min(v.lowLink, tv.index)
-		}
+	if !isRoot {
+		return nil
 	}
 
-	if v.lowLink == v.index {
-		var scc []*tarjanVertex[T]
-		for {
-			w := (*stack)[len(*stack)-1]
-			*stack = (*stack)[:len(*stack)-1]
-			w.onStack = false
-			scc = append(scc, w)
-			if w == v {
-				break
-			}
+	var scc []*gograph.Vertex[T]
+	for {
+		w := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack[w.Label()] = false
+		scc = append(scc, w)
+		if w.Label() == label {
+			break
 		}
-		*sccs = append(*sccs, scc)
 	}
-}
 
-// min is a helper function that returns the minimum of two integers.
-func min(x, y int) int {
-	if x < y {
-		return x
-	}
-	return y
+	t.sccs = append(t.sccs, scc)
+	return nil
 }
@@ -49,3 +49,56 @@ func TestTarjan(t *testing.T) {
 		}
 	}
 }
+
+// sortedComponents returns the sorted labels of each component, in the
+// order of the smallest labels.
+func sortedComponents(sccs [][]*gograph.Vertex[int]) [][]int {
+	result := make([][]int, 0, len(sccs))
+	for _, scc := range sccs {
+		var labels []int
+		for _, v := range scc {
+			labels = append(labels, v.Label())
+		}
+
+		sort.Ints(labels)
+		result = append(result, labels)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i][0] < result[j][0] })
+	return result
+}
+
+func TestTarjan_Undirected(t *testing.T) {
+	// two triangles connected by the bridge 3 - 4, and the isolated vertex 7.
+	g := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 6}, {6, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(7)
+
+	expected := [][]int{{1, 2, 3, 4, 5, 6}, {7}}
+	if sccs := sortedComponents(Tarjan(g)); !reflect.DeepEqual(expected, sccs) {
+		t.Errorf("Expected %v, got %v", expected, sccs)
+	}
+
+	if sccs := sortedComponents(Kosaraju(g)); !reflect.DeepEqual(expected, sccs) {
+		t.Errorf("Expected %v from Kosaraju, got %v", expected, sccs)
+	}
+}
+
+func TestTarjan_Deep(t *testing.T) {
+	// a long cycle doesn't overflow the stack.
+	n := 20000
+	g := gograph.New[int](gograph.Directed())
+	for i := 0; i < n; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex((i+1)%n))
+	}
+
+	if sccs := Tarjan(g); len(sccs) != 1 || len(sccs[0]) != n {
+		t.Errorf("Expected a single SCC with %d vertices, got %d SCCs", n, len(sccs))
+	}
+
+	if sccs := Kosaraju(g); len(sccs) != 1 || len(sccs[0]) != n {
+		t.Errorf("Expected a single SCC with %d vertices from Kosaraju, got %d SCCs", n, len(sccs))
+	}
+}
@@ -0,0 +1,74 @@
+package diagram
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// mermaidEscaper replaces the characters that end a quoted Mermaid text.
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;", "\n", "<br>")
+
+// WriteMermaid writes the graph as a Mermaid flowchart, which can be
+// embedded in Markdown documents.
+//
+// Vertices are written as nodes with stable identifiers (n0, n1, ...)
+// assigned in the label order, and the text of the nodes and edges is
+// set by the options. Directed graphs use arrows, undirected graphs
+// use plain links and write each edge once.
+//
+// Example output:
+//
+//	flowchart LR
+//	    subgraph g1 ["group 1"]
+//	        n0["A"]
+//	    end
+//	    n1["B"]
+//	    n0 -->|"2"| n1
+func WriteMermaid[T comparable](w io.Writer, g gograph.Graph[T], options ...OptionFunc[T]) error {
+	opts := newOptions(g, options...)
+	l := newParts(g, opts)
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(bw, "flowchart %s\n", opts.direction)
+
+	writeNode := func(indent string, v *gograph.Vertex[T]) {
+		_, _ = fmt.Fprintf(bw, "%s%s[\"%s\"]\n", indent, l.ids[v.Label()], mermaidEscaper.Replace(opts.nodeLabel(v)))
+	}
+
+	for i, group := range l.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(bw, "    subgraph g%d [\"%s\"]\n", i+1, mermaidEscaper.Replace(opts.groupName(i)))
+		for _, v := range group {
+			writeNode("        ", v)
+		}
+		_, _ = fmt.Fprintln(bw, "    end")
+	}
+
+	for _, v := range l.ungrouped {
+		writeNode("    ", v)
+	}
+
+	link := "---"
+	if g.IsDirected() {
+		link = "-->"
+	}
+
+	for _, e := range l.edges {
+		label := opts.edgeLabel(e.edge)
+		if label == "" {
+			_, _ = fmt.Fprintf(bw, "    %s %s %s\n", e.sourceID, link, e.destID)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(bw, "    %s %s|\"%s\"| %s\n", e.sourceID, link, mermaidEscaper.Replace(label), e.destID)
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,67 @@
+package diagram
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestWriteMermaid(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	vA := g.AddVertexByLabel("A", gograph.WithVertexMetadata(`api "gateway"`))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(0.5))
+
+	var buf bytes.Buffer
+	err := WriteMermaid(
+		&buf,
+		g,
+		WithDirection[string](LeftRight),
+		WithGroups([][]*gograph.Vertex[string]{{vA, vB}}),
+		WithGroupNames[string]("backend"),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `flowchart LR
+    subgraph g1 ["backend"]
+        n0["api #quot;gateway#quot;"]
+        n1["B"]
+    end
+    n2["C"]
+    n0 -->|"2"| n1
+    n1 -->|"0.5"| n2
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteMermaid_Undirected(t *testing.T) {
+	g := gograph.New[int]()
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
+
+	var buf bytes.Buffer
+	err := WriteMermaid(&buf, g, WithEdgeLabel(func(e *gograph.Edge[int]) string { return "link" }))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "flowchart TD\n") {
+		t.Errorf("Expected default direction TD, but got:\n%s", buf.String())
+	}
+
+	if strings.Count(buf.String(), "---") != 2 {
+		t.Errorf("Expected 2 undirected links, but got:\n%s", buf.String())
+	}
+
+	if !strings.Contains(buf.String(), `n0 ---|"link"| n1`) {
+		t.Errorf("Expected labeled link, but got:\n%s", buf.String())
+	}
+}
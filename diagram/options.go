@@ -0,0 +1,188 @@
+package diagram
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// Direction is the layout direction hint of a diagram.
+type Direction string
+
+const (
+	TopDown   Direction = "TD" // top to bottom
+	LeftRight Direction = "LR" // left to right
+	BottomTop Direction = "BT" // bottom to top, Mermaid only
+	RightLeft Direction = "RL" // right to left, Mermaid only
+)
+
+// OptionFunc represent an alias of function type that
+// modifies the specified diagram options.
+type OptionFunc[T comparable] func(options *Options[T])
+
+// Options represents the options of the diagram writers.
+type Options[T comparable] struct {
+	direction  Direction
+	nodeLabel  func(v *gograph.Vertex[T]) string
+	edgeLabel  func(e *gograph.Edge[T]) string
+	groups     [][]*gograph.Vertex[T]
+	groupNames []string
+}
+
+// WithDirection sets the layout direction of the diagram. The default
+// direction is TopDown.
+func WithDirection[T comparable](direction Direction) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.direction = direction
+	}
+}
+
+// WithNodeLabel sets the function that returns the text of each vertex.
+//
+// By default, the text is the vertex metadata if it is a string or a
+// fmt.Stringer, and the vertex label otherwise.
+func WithNodeLabel[T comparable](f func(v *gograph.Vertex[T]) string) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.nodeLabel = f
+	}
+}
+
+// WithEdgeLabel sets the function that returns the text of each edge.
+// An empty text draws the edge without a label.
+//
+// By default, edges of weighted graphs are labeled by their weights, and
+// the edges of unweighted graphs have no label.
+func WithEdgeLabel[T comparable](f func(e *gograph.Edge[T]) string) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.edgeLabel = f
+	}
+}
+
+// WithGroups draws each group of vertices inside a subgraph. It accepts
+// the output of partitioning algorithms such as the Supernodes of the
+// partition.KCutResult. A vertex that belongs to more than one group is
+// drawn in the first one, and vertices without a group are drawn outside
+// of all the subgraphs.
+//
+// Groups are named "group 1", "group 2" and so on, unless the names are
+// set with WithGroupNames.
+func WithGroups[T comparable](groups [][]*gograph.Vertex[T]) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.groups = groups
+	}
+}
+
+// WithGroupNames sets the names of the subgraphs that are created by
+// WithGroups, in the same order as the groups.
+func WithGroupNames[T comparable](names ...string) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.groupNames = names
+	}
+}
+
+func newOptions[T comparable](g gograph.Graph[T], options ...OptionFunc[T]) *Options[T] {
+	opts := &Options[T]{
+		direction: TopDown,
+		nodeLabel: util.VertexText[T],
+		edgeLabel: func(e *gograph.Edge[T]) string {
+			if g.IsWeighted() {
+				return strconv.FormatFloat(e.Weight(), 'g', -1, 64)
+			}
+
+			return ""
+		},
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
+
+func (o *Options[T]) groupName(i int) string {
+	if i < len(o.groupNames) {
+		return o.groupNames[i]
+	}
+
+	return fmt.Sprintf("group %d", i+1)
+}
+
+// diagramEdge is an edge with the identifiers of its endpoints.
+type diagramEdge[T comparable] struct {
+	edge     *gograph.Edge[T]
+	sourceID string
+	destID   string
+}
+
+// parts contains the parts of a graph in the order that they are written.
+type parts[T comparable] struct {
+	ids       map[T]string           // vertex label -> diagram identifier
+	groups    [][]*gograph.Vertex[T] // vertices of each group
+	ungrouped []*gograph.Vertex[T]   // vertices without a group
+	edges     []diagramEdge[T]
+}
+
+// newParts assigns a stable identifier to each vertex, distributes the
+// vertices between the groups, and lists the edges. In undirected graphs,
+// each edge is listed once.
+func newParts[T comparable](g gograph.Graph[T], opts *Options[T]) *parts[T] {
+	vertices := util.SortedVertices(g)
+	l := &parts[T]{
+		ids:    make(map[T]string, len(vertices)),
+		groups: make([][]*gograph.Vertex[T], len(opts.groups)),
+	}
+
+	positions := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		l.ids[v.Label()] = "n" + strconv.Itoa(i)
+		positions[v.Label()] = i
+	}
+
+	grouped := make(map[T]bool)
+	for i, group := range opts.groups {
+		for _, v := range group {
+			if v == nil || grouped[v.Label()] || l.ids[v.Label()] == "" {
+				continue
+			}
+
+			grouped[v.Label()] = true
+			l.groups[i] = append(l.groups[i], g.GetVertexByID(v.Label()))
+		}
+	}
+
+	for _, v := range vertices {
+		if !grouped[v.Label()] {
+			l.ungrouped = append(l.ungrouped, v)
+		}
+	}
+
+	for _, v := range vertices {
+		written := make(map[T]bool)
+		for _, neighbor := range v.Neighbors() {
+			if written[neighbor.Label()] {
+				continue
+			}
+
+			if !g.IsDirected() && positions[neighbor.Label()] < positions[v.Label()] {
+				continue
+			}
+
+			written[neighbor.Label()] = true
+			edge := g.GetEdge(v, neighbor)
+			if edge == nil {
+				continue
+			}
+
+			l.edges = append(l.edges, diagramEdge[T]{
+				edge:     edge,
+				sourceID: l.ids[v.Label()],
+				destID:   l.ids[neighbor.Label()],
+			})
+		}
+	}
+
+	return l
+}
@@ -0,0 +1,40 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestNewParts(t *testing.T) {
+	g := gograph.New[string]()
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vB, vA)
+	_, _ = g.AddEdge(vA, vC)
+
+	opts := newOptions(g, WithGroups([][]*gograph.Vertex[string]{{vA, vB}, {vA}}))
+	p := newParts(g, opts)
+
+	if p.ids["A"] != "n0" || p.ids["C"] != "n2" {
+		t.Errorf("Unexpected identifiers %v", p.ids)
+	}
+
+	if len(p.groups[0]) != 2 || len(p.groups[1]) != 0 {
+		t.Errorf("Expected A and B in the first group only, but got %v", p.groups)
+	}
+
+	if len(p.ungrouped) != 1 || p.ungrouped[0].Label() != "C" {
+		t.Errorf("Expected C to be ungrouped, but got %v", p.ungrouped)
+	}
+
+	// undirected edges are listed once
+	if len(p.edges) != 2 {
+		t.Errorf("Expected 2 edges, but got %d", len(p.edges))
+	}
+
+	if opts.groupName(0) != "group 1" {
+		t.Errorf("Expected group name %q, but got %q", "group 1", opts.groupName(0))
+	}
+}
@@ -0,0 +1,83 @@
+package diagram
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// plantUMLEscaper replaces the characters that end a quoted PlantUML text.
+var plantUMLEscaper = strings.NewReplacer(`"`, "'", "\n", `\n`)
+
+// WritePlantUML writes the graph as a PlantUML diagram, where vertices are
+// rectangles and groups are packages.
+//
+// PlantUML only supports the top to bottom and left to right directions,
+// so BottomTop is written as TopDown, and RightLeft as LeftRight. See
+// WriteMermaid for the description of the identifiers and the labels.
+//
+// Example output:
+//
+//	@startuml
+//	left to right direction
+//	package "group 1" {
+//	    rectangle "A" as n0
+//	}
+//	rectangle "B" as n1
+//	n0 --> n1 : 2
+//	@enduml
+func WritePlantUML[T comparable](w io.Writer, g gograph.Graph[T], options ...OptionFunc[T]) error {
+	opts := newOptions(g, options...)
+	l := newParts(g, opts)
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintln(bw, "@startuml")
+
+	switch opts.direction {
+	case LeftRight, RightLeft:
+		_, _ = fmt.Fprintln(bw, "left to right direction")
+	default:
+		_, _ = fmt.Fprintln(bw, "top to bottom direction")
+	}
+
+	writeNode := func(indent string, v *gograph.Vertex[T]) {
+		_, _ = fmt.Fprintf(bw, "%srectangle \"%s\" as %s\n", indent, plantUMLEscaper.Replace(opts.nodeLabel(v)), l.ids[v.Label()])
+	}
+
+	for i, group := range l.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(bw, "package \"%s\" {\n", plantUMLEscaper.Replace(opts.groupName(i)))
+		for _, v := range group {
+			writeNode("    ", v)
+		}
+		_, _ = fmt.Fprintln(bw, "}")
+	}
+
+	for _, v := range l.ungrouped {
+		writeNode("", v)
+	}
+
+	link := "--"
+	if g.IsDirected() {
+		link = "-->"
+	}
+
+	for _, e := range l.edges {
+		label := opts.edgeLabel(e.edge)
+		if label == "" {
+			_, _ = fmt.Fprintf(bw, "%s %s %s\n", e.sourceID, link, e.destID)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(bw, "%s %s %s : %s\n", e.sourceID, link, e.destID, plantUMLEscaper.Replace(label))
+	}
+
+	_, _ = fmt.Fprintln(bw, "@enduml")
+	return bw.Flush()
+}
@@ -0,0 +1,66 @@
+package diagram
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestWritePlantUML(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	vA := g.AddVertexByLabel("A", gograph.WithVertexMetadata("gateway"))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(3))
+
+	var buf bytes.Buffer
+	err := WritePlantUML(
+		&buf,
+		g,
+		WithDirection[string](RightLeft),
+		WithGroups([][]*gograph.Vertex[string]{{vA}, {vC}}),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `@startuml
+left to right direction
+package "group 1" {
+    rectangle "gateway" as n0
+}
+package "group 2" {
+    rectangle "C" as n2
+}
+rectangle "B" as n1
+n0 --> n1 : 2
+n1 --> n2 : 3
+@enduml
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestWritePlantUML_Undirected(t *testing.T) {
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+
+	var buf bytes.Buffer
+	if err := WritePlantUML(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `@startuml
+top to bottom direction
+rectangle "A" as n0
+rectangle "B" as n1
+n0 -- n1
+@enduml
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
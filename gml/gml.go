@@ -0,0 +1,297 @@
+package gml
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+var ErrInvalidFormat = errors.New("invalid GML data")
+
+// Attributes holds the GML attributes of a node or an edge, such as the
+// label or the graphics. Values are int64, float64, string, or nested
+// Attributes. Read stores them as the metadata of the vertices and edges,
+// and Write writes the metadata of this type back as attributes.
+type Attributes map[string]any
+
+// Read reads the first graph of a GML document. The vertices are labeled
+// by the node ids, and all the other node and edge attributes are stored
+// in the vertex and edge metadata as Attributes.
+//
+// The graph is directed if the graph has the "directed 1" attribute, and
+// it is weighted if any edge has a numeric "weight" or "value" attribute,
+// which becomes the edge weight. A numeric "weight" attribute of a node
+// becomes the vertex weight. Repeated edges are added once, since gograph
+// doesn't support parallel edges.
+//
+// Malformed input, nodes without an id, and edges with unknown endpoints
+// return an error that wraps ErrInvalidFormat.
+func Read(r io.Reader) (gograph.Graph[int], error) {
+	root, err := newParser(r).parseList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, ok := find(root, "graph").(list)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing graph", ErrInvalidFormat)
+	}
+
+	type node struct {
+		id     int
+		weight float64
+		attrs  Attributes
+	}
+
+	type edge struct {
+		source, target int
+		weight         float64
+		attrs          Attributes
+	}
+
+	var (
+		nodes    []node
+		edges    []edge
+		directed bool
+		weighted bool
+	)
+
+	for _, p := range graph {
+		switch p.key {
+		case "directed":
+			directed = p.value == int64(1)
+		case "node":
+			l, ok := p.value.(list)
+			if !ok {
+				return nil, fmt.Errorf("%w: node is not a list", ErrInvalidFormat)
+			}
+
+			id, ok := l.int("id")
+			if !ok {
+				return nil, fmt.Errorf("%w: node without id", ErrInvalidFormat)
+			}
+
+			weight, _ := l.number("weight")
+			nodes = append(nodes, node{id: id, weight: weight, attrs: l.attributes("id")})
+		case "edge":
+			l, ok := p.value.(list)
+			if !ok {
+				return nil, fmt.Errorf("%w: edge is not a list", ErrInvalidFormat)
+			}
+
+			source, okSource := l.int("source")
+			target, okTarget := l.int("target")
+			if !okSource || !okTarget {
+				return nil, fmt.Errorf("%w: edge without source or target", ErrInvalidFormat)
+			}
+
+			weight, hasWeight := l.number("weight")
+			if !hasWeight {
+				weight, hasWeight = l.number("value")
+			}
+
+			weighted = weighted || hasWeight
+			edges = append(edges, edge{source: source, target: target, weight: weight, attrs: l.attributes("source", "target")})
+		}
+	}
+
+	var options []gograph.GraphOptionFunc
+	if directed {
+		options = append(options, gograph.Directed())
+	}
+
+	if weighted {
+		options = append(options, gograph.Weighted())
+	}
+
+	g := gograph.New[int](options...)
+	for _, n := range nodes {
+		v := g.AddVertexByLabel(n.id, gograph.WithVertexWeight(n.weight), gograph.WithVertexMetadata(n.attrs))
+		if v == nil {
+			return nil, fmt.Errorf("%w: duplicate node id %d", ErrInvalidFormat, n.id)
+		}
+	}
+
+	for _, e := range edges {
+		source, target := g.GetVertexByID(e.source), g.GetVertexByID(e.target)
+		if source == nil || target == nil {
+			return nil, fmt.Errorf("%w: edge %d -> %d has an unknown node", ErrInvalidFormat, e.source, e.target)
+		}
+
+		if g.ContainsEdge(source, target) {
+			continue
+		}
+
+		_, err = g.AddEdge(source, target, gograph.WithEdgeWeight(e.weight), gograph.WithEdgeMetadata(e.attrs))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// Write writes the graph as a GML document.
+//
+// Integer labels are written as the node ids. Vertices with other label
+// types get sequential ids in the label order, and their labels are
+// written as the "label" attribute. Vertex and edge weights are written
+// as "weight" attributes, and metadata of type Attributes is written as
+// additional attributes. In undirected graphs, each edge is written once.
+func Write[T comparable](w io.Writer, g gograph.Graph[T]) error {
+	vertices := util.SortedVertices(g)
+
+	ids := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		ids[v.Label()] = i
+		if id, ok := any(v.Label()).(int); ok {
+			ids[v.Label()] = id
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintln(bw, "graph [")
+	if g.IsDirected() {
+		_, _ = fmt.Fprintln(bw, "  directed 1")
+	}
+
+	for _, v := range vertices {
+		attrs, _ := v.Metadata().(Attributes)
+
+		_, _ = fmt.Fprintln(bw, "  node [")
+		_, _ = fmt.Fprintf(bw, "    id %d\n", ids[v.Label()])
+		if _, ok := any(v.Label()).(int); !ok {
+			writeValue(bw, "    ", "label", fmt.Sprint(v.Label()))
+		} else if label, ok := attrs["label"]; ok {
+			writeValue(bw, "    ", "label", label)
+		}
+
+		if v.Weight() != 0 {
+			writeValue(bw, "    ", "weight", v.Weight())
+		}
+
+		writeAttributes(bw, "    ", attrs, "id", "label", "weight")
+		_, _ = fmt.Fprintln(bw, "  ]")
+	}
+
+	for _, edge := range g.AllEdges() {
+		source, target := ids[edge.Source().Label()], ids[edge.Destination().Label()]
+		if !g.IsDirected() && util.CompareLabels(edge.Source().Label(), edge.Destination().Label()) > 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintln(bw, "  edge [")
+		_, _ = fmt.Fprintf(bw, "    source %d\n    target %d\n", source, target)
+		if g.IsWeighted() {
+			writeValue(bw, "    ", "weight", edge.Weight())
+		}
+
+		attrs, _ := edge.Metadata().(Attributes)
+		writeAttributes(bw, "    ", attrs, "source", "target", "weight")
+		_, _ = fmt.Fprintln(bw, "  ]")
+	}
+
+	_, _ = fmt.Fprintln(bw, "]")
+	return bw.Flush()
+}
+
+func writeAttributes(w io.Writer, indent string, attrs Attributes, skip ...string) {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		if isKey(key) && !contains(skip, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeValue(w, indent, key, attrs[key])
+	}
+}
+
+func writeValue(w io.Writer, indent, key string, value any) {
+	switch v := value.(type) {
+	case int:
+		_, _ = fmt.Fprintf(w, "%s%s %d\n", indent, key, v)
+	case int64:
+		_, _ = fmt.Fprintf(w, "%s%s %d\n", indent, key, v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".eEIN") {
+			// keep the value real, so it is read back as float64
+			s += ".0"
+		}
+		_, _ = fmt.Fprintf(w, "%s%s %s\n", indent, key, s)
+	case Attributes:
+		_, _ = fmt.Fprintf(w, "%s%s [\n", indent, key)
+		writeAttributes(w, indent+"  ", v)
+		_, _ = fmt.Fprintf(w, "%s]\n", indent)
+	default:
+		_, _ = fmt.Fprintf(w, "%s%s \"%s\"\n", indent, key, html.EscapeString(fmt.Sprint(v)))
+	}
+}
+
+// find returns the value of the first pair with the specified key.
+func find(l list, key string) any {
+	for _, p := range l {
+		if p.key == key {
+			return p.value
+		}
+	}
+
+	return nil
+}
+
+func (l list) int(key string) (int, bool) {
+	v, ok := find(l, key).(int64)
+	return int(v), ok
+}
+
+func (l list) number(key string) (float64, bool) {
+	switch v := find(l, key).(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+// attributes converts the list to Attributes, except the skipped keys.
+// If a key is repeated, the last value is kept.
+func (l list) attributes(skip ...string) Attributes {
+	attrs := make(Attributes, len(l))
+	for _, p := range l {
+		if contains(skip, p.key) {
+			continue
+		}
+
+		if nested, ok := p.value.(list); ok {
+			attrs[p.key] = nested.attributes()
+			continue
+		}
+
+		attrs[p.key] = p.value
+	}
+
+	return attrs
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,181 @@
+package gml
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+const lesMiserablesSample = `Creator "sample"
+graph
+[
+  node
+  [
+    id 0
+    label "Myriel"
+  ]
+  node
+  [
+    id 1
+    label "Napoleon"
+  ]
+  node
+  [
+    id 2
+    label "MlleBaptistine"
+    graphics [ x 10.5 y 20 ]
+  ]
+  edge
+  [
+    source 1
+    target 0
+    value 1
+  ]
+  edge
+  [
+    source 2
+    target 0
+    value 8
+  ]
+]
+`
+
+func TestRead(t *testing.T) {
+	g, err := Read(strings.NewReader(lesMiserablesSample))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if g.IsDirected() || !g.IsWeighted() {
+		t.Errorf("Expected undirected weighted graph")
+	}
+
+	if g.Order() != 3 {
+		t.Errorf("Expected 3 vertices, but got %d", g.Order())
+	}
+
+	attrs, ok := g.GetVertexByID(2).Metadata().(Attributes)
+	if !ok || attrs["label"] != "MlleBaptistine" {
+		t.Errorf("Expected label attribute, but got %+v", g.GetVertexByID(2).Metadata())
+	}
+
+	graphics, ok := attrs["graphics"].(Attributes)
+	if !ok || graphics["x"] != 10.5 || graphics["y"] != int64(20) {
+		t.Errorf("Expected nested graphics attributes, but got %+v", attrs["graphics"])
+	}
+
+	edge := g.GetEdge(g.GetVertexByID(0), g.GetVertexByID(2))
+	if edge == nil || edge.Weight() != 8 {
+		t.Errorf("Expected undirected edge 0 - 2 with weight 8, but got %+v", edge)
+	}
+}
+
+func TestRead_Directed(t *testing.T) {
+	input := `graph [ directed 1
+  node [ id 1 weight 3 ] node [ id 2 ]
+  edge [ source 1 target 2 ] edge [ source 1 target 2 ]
+]`
+	g, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsDirected() || g.IsWeighted() {
+		t.Errorf("Expected directed unweighted graph")
+	}
+
+	if g.Size() != 1 || g.ContainsEdge(g.GetVertexByID(2), g.GetVertexByID(1)) {
+		t.Errorf("Expected a single edge 1 -> 2, but got %d edges", g.Size())
+	}
+
+	if g.GetVertexByID(1).Weight() != 3 {
+		t.Errorf("Expected vertex weight 3, but got %f", g.GetVertexByID(1).Weight())
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	inputs := []string{
+		"",
+		"graph [ node [ label \"a\" ] ]",
+		"graph [ node [ id 1 ] node [ id 1 ] ]",
+		"graph [ node [ id 1 ] edge [ source 1 target 2 ] ]",
+		"graph [ edge [ source 1 ] ]",
+		"graph [ node 1 ]",
+	}
+
+	for _, input := range inputs {
+		if _, err := Read(strings.NewReader(input)); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, input, err)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	vA := g.AddVertexByLabel("A", gograph.WithVertexWeight(2))
+	vB := g.AddVertexByLabel("B", gograph.WithVertexMetadata(Attributes{"color": "red", "size": 3}))
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(1.5))
+
+	var buf bytes.Buffer
+	if err := Write(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `graph [
+  directed 1
+  node [
+    id 0
+    label "A"
+    weight 2.0
+  ]
+  node [
+    id 1
+    label "B"
+    color "red"
+    size 3
+  ]
+  edge [
+    source 0
+    target 1
+    weight 1.5
+  ]
+]
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	g, err := Read(strings.NewReader(lesMiserablesSample))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err = Write(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	clone, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s\n%s", err, buf.String())
+	}
+
+	if clone.Order() != g.Order() || clone.Size() != g.Size() {
+		t.Errorf("Expected %d vertices and %d edges, but got %d and %d", g.Order(), g.Size(), clone.Order(), clone.Size())
+	}
+
+	attrs, _ := clone.GetVertexByID(1).Metadata().(Attributes)
+	if attrs["label"] != "Napoleon" {
+		t.Errorf("Expected label Napoleon, but got %+v", attrs)
+	}
+
+	edge := clone.GetEdge(clone.GetVertexByID(2), clone.GetVertexByID(0))
+	if edge == nil || edge.Weight() != 8 {
+		t.Errorf("Expected edge 2 - 0 with weight 8, but got %+v", edge)
+	}
+}
@@ -0,0 +1,169 @@
+package gml
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// pair is a key-value pair of a GML list. The value is an int64, a
+// float64, a string, or a list.
+type pair struct {
+	key   string
+	value any
+}
+
+// list is a GML list. Keys are not unique, e.g. a graph contains many
+// node keys.
+type list []pair
+
+// parser is a recursive descent parser of the GML syntax:
+//
+//	list  = { key value }
+//	value = integer | real | string | "[" list "]"
+type parser struct {
+	r    *bufio.Reader
+	line int
+}
+
+func newParser(r io.Reader) *parser {
+	return &parser{r: bufio.NewReader(r), line: 1}
+}
+
+// parseList parses key-value pairs until the end of the input, or the
+// closing bracket if nested is true.
+func (p *parser) parseList(nested bool) (list, error) {
+	var out list
+	for {
+		token, err := p.next()
+		if err == io.EOF {
+			if nested {
+				return nil, p.errorf("unexpected end of input, missing ']'")
+			}
+
+			return out, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if token == "]" {
+			if !nested {
+				return nil, p.errorf("unexpected ']'")
+			}
+
+			return out, nil
+		}
+
+		if !isKey(token) {
+			return nil, p.errorf("invalid key %q", token)
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, pair{key: token, value: value})
+	}
+}
+
+func (p *parser) parseValue() (any, error) {
+	token, err := p.next()
+	if err == io.EOF {
+		return nil, p.errorf("unexpected end of input, missing value")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case token == "[":
+		return p.parseList(true)
+	case strings.HasPrefix(token, `"`):
+		return html.UnescapeString(token[1 : len(token)-1]), nil
+	}
+
+	if i, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return i, nil
+	}
+
+	if f, err := strconv.ParseFloat(token, 64); err == nil {
+		return f, nil
+	}
+
+	return nil, p.errorf("invalid value %q", token)
+}
+
+// next returns the next token: a bracket, a quoted string including the
+// quotes, or a run of non-space characters. Lines starting with '#' are
+// comments.
+func (p *parser) next() (string, error) {
+	for {
+		r, _, err := p.r.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case r == '\n':
+			p.line++
+		case unicode.IsSpace(r):
+		case r == '#':
+			if _, err = p.r.ReadString('\n'); err != nil {
+				return "", err
+			}
+			p.line++
+		case r == '[' || r == ']':
+			return string(r), nil
+		case r == '"':
+			s, err := p.r.ReadString('"')
+			if err != nil {
+				return "", p.errorf("unterminated string")
+			}
+
+			p.line += strings.Count(s, "\n")
+			return `"` + s, nil
+		default:
+			var sb strings.Builder
+			sb.WriteRune(r)
+			for {
+				r, _, err = p.r.ReadRune()
+				if err == io.EOF {
+					return sb.String(), nil
+				}
+
+				if err != nil {
+					return "", err
+				}
+
+				if unicode.IsSpace(r) || r == '[' || r == ']' || r == '"' {
+					_ = p.r.UnreadRune()
+					return sb.String(), nil
+				}
+
+				sb.WriteRune(r)
+			}
+		}
+	}
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidFormat, p.line, fmt.Sprintf(format, args...))
+}
+
+func isKey(token string) bool {
+	for i, r := range token {
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+
+	return token != ""
+}
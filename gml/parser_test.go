@@ -0,0 +1,54 @@
+package gml
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParser(t *testing.T) {
+	input := `# comment line
+Creator "test"
+graph [
+  id 1 weight -2.5
+  label "a &quot;quoted&quot; name"
+  graphics [ x 1 y 2 ]
+]
+`
+	l, err := newParser(strings.NewReader(input)).parseList(false)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := list{
+		{key: "Creator", value: "test"},
+		{key: "graph", value: list{
+			{key: "id", value: int64(1)},
+			{key: "weight", value: -2.5},
+			{key: "label", value: `a "quoted" name`},
+			{key: "graphics", value: list{{key: "x", value: int64(1)}, {key: "y", value: int64(2)}}},
+		}},
+	}
+	if !reflect.DeepEqual(l, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, l)
+	}
+}
+
+func TestParser_Invalid(t *testing.T) {
+	inputs := []string{
+		"graph [ id 1",
+		"graph ]",
+		"graph [ id ]",
+		"graph [ 1 2 ]",
+		"graph [ id abc ]",
+		`graph [ label "unterminated ]`,
+		"graph",
+	}
+
+	for _, input := range inputs {
+		if _, err := newParser(strings.NewReader(input)).parseList(false); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, input, err)
+		}
+	}
+}
@@ -133,7 +133,6 @@ type Edge[T comparable] struct {
 	source     *Vertex[T] // start point of the edges
 	dest       *Vertex[T] // destination or end point of the edges
 	properties EdgeProperties
-	metadata   any // optional metadata associated with the edge
 }
 
 func NewEdge[T comparable](source *Vertex[T], dest *Vertex[T], options ...EdgeOptionFunc) *Edge[T] {
@@ -181,7 +180,7 @@ func (e *Edge[T]) Destination() *Vertex[T] {
 
 // Metadata returns the metadata associated with the edge.
 func (e Edge[T]) Metadata() any {
-	return e.metadata
+	return e.properties.metadata
 }
 
 // Vertex represents a node or point in a graph
@@ -189,12 +188,17 @@ type Vertex[T comparable] struct {
 	label      T            // uniquely identifies each vertex
 	neighbors  []*Vertex[T] // stores pointers to its neighbors
 	inDegree   int          // number of incoming edges to this vertex
+	sequence   uint64       // insertion order of the vertex in the graph
 	properties VertexProperties
-	metadata   any // optional metadata associated with the vertex
 }
 
 func NewVertex[T comparable](label T, options ...VertexOptionFunc) *Vertex[T] {
-	return &Vertex[T]{label: label}
+	var properties VertexProperties
+	for _, option := range options {
+		option(&properties)
+	}
+
+	return &Vertex[T]{label: label, properties: properties}
 }
 
 // NeighborByLabel iterates over the neighbor slice and returns the
@@ -259,5 +263,5 @@ func (v *Vertex[T]) Weight() float64 {
 
 // Metadata returns the metadata associated with the vertex.
 func (v *Vertex[T]) Metadata() any {
-	return v.metadata
+	return v.properties.metadata
 }
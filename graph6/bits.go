@@ -0,0 +1,102 @@
+package graph6
+
+// bitWriter packs bits into the 6-bit groups of the graph6 family
+// formats, most significant bit first.
+type bitWriter struct {
+	data []byte
+	n    int // number of bits written
+}
+
+func newBitWriter() *bitWriter {
+	return &bitWriter{}
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if w.n%6 == 0 {
+		w.data = append(w.data, 0)
+	}
+
+	if bit {
+		w.data[len(w.data)-1] |= 1 << (5 - w.n%6)
+	}
+
+	w.n++
+}
+
+// writeBits writes the k least significant bits of x, most significant
+// bit first.
+func (w *bitWriter) writeBits(x, k int) {
+	for i := k - 1; i >= 0; i-- {
+		w.writeBit(x>>i&1 == 1)
+	}
+}
+
+// padding returns the number of bits needed to complete the last group.
+func (w *bitWriter) padding() int {
+	return (6 - w.n%6) % 6
+}
+
+// string returns the printable representation of the written bits. The
+// last group is padded with the specified bit value.
+func (w *bitWriter) string(pad byte) string {
+	for w.n%6 != 0 {
+		w.writeBit(pad == 1)
+	}
+
+	out := make([]byte, len(w.data))
+	for i, b := range w.data {
+		out[i] = b + 63
+	}
+
+	return string(out)
+}
+
+// bitReader reads the bits of the 6-bit groups of the graph6 family
+// formats, most significant bit first.
+type bitReader struct {
+	data []byte // 6-bit values, without the 63 offset
+	n    int    // number of bits read
+}
+
+// newBitReader validates the printable characters and creates a reader.
+func newBitReader(data []byte) (*bitReader, error) {
+	values := make([]byte, len(data))
+	for i, b := range data {
+		if b < 63 || b > 126 {
+			return nil, invalidFormat("invalid character %q", b)
+		}
+
+		values[i] = b - 63
+	}
+
+	return &bitReader{data: values}, nil
+}
+
+// remaining returns the number of unread bits.
+func (r *bitReader) remaining() int {
+	return len(r.data)*6 - r.n
+}
+
+// readBit reads the next bit. It returns false if there are no more bits.
+func (r *bitReader) readBit() bool {
+	if r.remaining() <= 0 {
+		return false
+	}
+
+	bit := r.data[r.n/6]>>(5-r.n%6)&1 == 1
+	r.n++
+	return bit
+}
+
+// readBits reads a k-bit number, most significant bit first.
+func (r *bitReader) readBits(k int) int {
+	x := 0
+	for i := 0; i < k; i++ {
+		x <<= 1
+		if r.readBit() {
+			x |= 1
+		}
+	}
+
+	return x
+}
@@ -0,0 +1,36 @@
+package graph6
+
+import "testing"
+
+func TestBits(t *testing.T) {
+	w := newBitWriter()
+	w.writeBits(5, 3)
+	w.writeBit(true)
+
+	if w.padding() != 2 {
+		t.Errorf("Expected padding 2, but got %d", w.padding())
+	}
+
+	s := w.string(0)
+	if s != string([]byte{0b101100 + 63}) {
+		t.Errorf("Unexpected encoded bits %q", s)
+	}
+
+	r, err := newBitReader([]byte(s))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if r.readBits(3) != 5 || !r.readBit() || r.remaining() != 2 {
+		t.Errorf("Unexpected decoded bits")
+	}
+
+	r.readBits(2)
+	if r.readBit() {
+		t.Errorf("Expected false when there are no more bits")
+	}
+
+	if _, err = newBitReader([]byte{0}); err == nil {
+		t.Errorf("Expected error for invalid character")
+	}
+}
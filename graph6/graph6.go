@@ -0,0 +1,305 @@
+package graph6
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+)
+
+var (
+	ErrInvalidFormat   = errors.New("invalid graph6 data")
+	ErrDirectedGraph   = errors.New("graph6 and sparse6 require an undirected graph")
+	ErrUndirectedGraph = errors.New("digraph6 requires a directed graph")
+	ErrSelfLoop        = errors.New("graph6 doesn't support self-loops")
+)
+
+const (
+	graph6Header   = ">>graph6<<"
+	sparse6Header  = ">>sparse6<<"
+	digraph6Header = ">>digraph6<<"
+
+	sparse6Prefix  = ':'
+	digraph6Prefix = '&'
+
+	// maxOrder is the maximum number of vertices accepted by the decoders.
+	// It protects the decoders from allocating huge graphs for corrupt
+	// sparse6 input, which may declare any number of isolated vertices.
+	maxOrder = 1 << 24
+)
+
+// EncodeGraph6 encodes the undirected graph in the graph6 format. The
+// vertices are numbered in the ascending order of their labels, so a
+// graph with labels 0..n-1 keeps its labels.
+//
+// It returns ErrDirectedGraph for directed graphs and ErrSelfLoop if the
+// graph contains a self-loop, since graph6 can only represent simple
+// undirected graphs.
+func EncodeGraph6(g gograph.Graph[int]) (string, error) {
+	if g.IsDirected() {
+		return "", ErrDirectedGraph
+	}
+
+	n, positions := numberVertices(g)
+
+	bits := newBitWriter()
+	adjacent := make(map[[2]int]bool)
+	for _, edge := range g.AllEdges() {
+		i, j := positions[edge.Source().Label()], positions[edge.Destination().Label()]
+		if i == j {
+			return "", ErrSelfLoop
+		}
+
+		adjacent[[2]int{min(i, j), max(i, j)}] = true
+	}
+
+	// upper triangle of the adjacency matrix in column order:
+	// x(0,1), x(0,2), x(1,2), x(0,3), x(1,3), x(2,3), ...
+	for j := 1; j < n; j++ {
+		for i := 0; i < j; i++ {
+			bits.writeBit(adjacent[[2]int{i, j}])
+		}
+	}
+
+	return encodeOrder(n) + bits.string(0), nil
+}
+
+// DecodeGraph6 decodes a graph in the graph6 format. The optional
+// ">>graph6<<" header and the surrounding whitespace are ignored.
+//
+// The vertices of the returned undirected graph are labeled 0..n-1.
+func DecodeGraph6(s string) (gograph.Graph[int], error) {
+	data := []byte(strings.TrimPrefix(strings.TrimSpace(s), graph6Header))
+
+	n, data, err := decodeOrder(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkDataLength(data, n*(n-1)/2); err != nil {
+		return nil, err
+	}
+
+	bits, err := newBitReader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g, vertices := newGraph(n)
+	for j := 1; j < n; j++ {
+		for i := 0; i < j; i++ {
+			if bits.readBit() {
+				_, _ = g.AddEdge(vertices[i], vertices[j])
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// EncodeDigraph6 encodes the directed graph in the digraph6 format. The
+// vertices are numbered in the ascending order of their labels. Self-loops
+// are supported.
+//
+// It returns ErrUndirectedGraph for undirected graphs.
+func EncodeDigraph6(g gograph.Graph[int]) (string, error) {
+	if !g.IsDirected() {
+		return "", ErrUndirectedGraph
+	}
+
+	n, positions := numberVertices(g)
+
+	adjacent := make(map[[2]int]bool)
+	for _, edge := range g.AllEdges() {
+		adjacent[[2]int{positions[edge.Source().Label()], positions[edge.Destination().Label()]}] = true
+	}
+
+	// the full adjacency matrix in row order.
+	bits := newBitWriter()
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			bits.writeBit(adjacent[[2]int{i, j}])
+		}
+	}
+
+	return string(digraph6Prefix) + encodeOrder(n) + bits.string(0), nil
+}
+
+// DecodeDigraph6 decodes a graph in the digraph6 format. The optional
+// ">>digraph6<<" header and the surrounding whitespace are ignored.
+//
+// The vertices of the returned directed graph are labeled 0..n-1.
+func DecodeDigraph6(s string) (gograph.Graph[int], error) {
+	data := []byte(strings.TrimPrefix(strings.TrimSpace(s), digraph6Header))
+	if len(data) == 0 || data[0] != digraph6Prefix {
+		return nil, invalidFormat("missing digraph6 prefix")
+	}
+
+	n, data, err := decodeOrder(data[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkDataLength(data, n*n); err != nil {
+		return nil, err
+	}
+
+	bits, err := newBitReader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g, vertices := newGraph(n, gograph.Directed())
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if bits.readBit() {
+				_, _ = g.AddEdge(vertices[i], vertices[j])
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// Decode decodes a single graph in any of the graph6, sparse6 and digraph6
+// formats. The format is detected from the header or the first character.
+func Decode(s string) (gograph.Graph[int], error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, sparse6Header) || strings.HasPrefix(s, string(sparse6Prefix)):
+		return DecodeSparse6(s)
+	case strings.HasPrefix(s, digraph6Header) || strings.HasPrefix(s, string(digraph6Prefix)):
+		return DecodeDigraph6(s)
+	default:
+		return DecodeGraph6(s)
+	}
+}
+
+// ReadAll decodes all the graphs of a file in any of the graph6 family
+// formats, one graph per line, as generated by nauty tools such as geng.
+// Empty lines are skipped.
+//
+// It stops at the first invalid line and returns an error that contains
+// the line number.
+func ReadAll(r io.Reader) ([]gograph.Graph[int], error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	var graphs []gograph.Graph[int]
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		g, err := Decode(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		graphs = append(graphs, g)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return graphs, nil
+}
+
+// numberVertices assigns the positions 0..n-1 to the vertices in the
+// ascending order of their labels.
+func numberVertices(g gograph.Graph[int]) (int, map[int]int) {
+	vertices := g.GetAllVertices()
+	labels := make([]int, len(vertices))
+	for i, v := range vertices {
+		labels[i] = v.Label()
+	}
+
+	sort.Ints(labels)
+
+	positions := make(map[int]int, len(labels))
+	for i, label := range labels {
+		positions[label] = i
+	}
+
+	return len(labels), positions
+}
+
+// newGraph creates a graph with the vertices 0..n-1.
+func newGraph(n int, options ...gograph.GraphOptionFunc) (gograph.Graph[int], []*gograph.Vertex[int]) {
+	g := gograph.New[int](options...)
+	vertices := make([]*gograph.Vertex[int], n)
+	for i := range vertices {
+		vertices[i] = g.AddVertexByLabel(i)
+	}
+
+	return g, vertices
+}
+
+// encodeOrder returns the N(n) representation of the number of vertices.
+func encodeOrder(n int) string {
+	switch {
+	case n <= 62:
+		return string([]byte{byte(n + 63)})
+	case n <= 258047:
+		return string([]byte{126, byte(n>>12&63 + 63), byte(n>>6&63 + 63), byte(n&63 + 63)})
+	default:
+		out := []byte{126, 126}
+		for shift := 30; shift >= 0; shift -= 6 {
+			out = append(out, byte(n>>shift&63+63))
+		}
+
+		return string(out)
+	}
+}
+
+// decodeOrder parses the N(n) representation of the number of vertices
+// and returns the rest of the data.
+func decodeOrder(data []byte) (int, []byte, error) {
+	start, end := 0, 1
+	if len(data) > 1 && data[0] == 126 && data[1] == 126 {
+		start, end = 2, 8
+	} else if len(data) > 0 && data[0] == 126 {
+		start, end = 1, 4
+	}
+
+	if len(data) < end {
+		return 0, nil, invalidFormat("truncated number of vertices")
+	}
+
+	n := 0
+	for _, b := range data[start:end] {
+		if b < 63 || b > 126 {
+			return 0, nil, invalidFormat("invalid character %q", b)
+		}
+
+		n = n<<6 | int(b-63)
+	}
+
+	if n > maxOrder {
+		return 0, nil, invalidFormat("number of vertices %d exceeds %d", n, maxOrder)
+	}
+
+	return n, data[end:], nil
+}
+
+// checkDataLength returns an error if the data doesn't have the bytes
+// needed for the specified number of bits. The decoders call it before
+// they allocate the graph, so a short header can't declare a huge graph
+// without the matrix to back it.
+func checkDataLength(data []byte, bits int) error {
+	if expected := (bits + 5) / 6; len(data) != expected {
+		return invalidFormat("expected %d data bytes, got %d", expected, len(data))
+	}
+
+	return nil
+}
+
+func invalidFormat(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidFormat, fmt.Sprintf(format, args...))
+}
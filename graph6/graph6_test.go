@@ -0,0 +1,184 @@
+package graph6
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func edgeSet(g gograph.Graph[int]) map[[2]int]bool {
+	edges := make(map[[2]int]bool)
+	for _, e := range g.AllEdges() {
+		i, j := e.Source().Label(), e.Destination().Label()
+		if !g.IsDirected() && i > j {
+			i, j = j, i
+		}
+
+		edges[[2]int{i, j}] = true
+	}
+
+	return edges
+}
+
+func assertEdges(t *testing.T, g gograph.Graph[int], order int, expected ...[2]int) {
+	t.Helper()
+
+	if int(g.Order()) != order {
+		t.Errorf("Expected %d vertices, but got %d", order, g.Order())
+	}
+
+	edges := edgeSet(g)
+	if len(edges) != len(expected) {
+		t.Errorf("Expected %d edges, but got %v", len(expected), edges)
+	}
+
+	for _, e := range expected {
+		if !edges[e] {
+			t.Errorf("Expected edge %v, but got %v", e, edges)
+		}
+	}
+}
+
+func TestGraph6(t *testing.T) {
+	// complete graph K4
+	g, err := DecodeGraph6("C~")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	assertEdges(t, g, 4, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 3})
+
+	s, err := EncodeGraph6(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if s != "C~" {
+		t.Errorf("Expected %q, but got %q", "C~", s)
+	}
+
+	// header and trailing newline
+	g, err = DecodeGraph6(">>graph6<<A_\n")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	assertEdges(t, g, 2, [2]int{0, 1})
+}
+
+func TestGraph6_LargeOrder(t *testing.T) {
+	g := gograph.New[int]()
+	for i := 0; i < 100; i++ {
+		g.AddVertexByLabel(i)
+	}
+	_, _ = g.AddEdge(g.GetVertexByID(3), g.GetVertexByID(97))
+
+	s, err := EncodeGraph6(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if s[0] != 126 {
+		t.Errorf("Expected the long order prefix, but got %q", s[:4])
+	}
+
+	decoded, err := DecodeGraph6(s)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	assertEdges(t, decoded, 100, [2]int{3, 97})
+
+	n, rest, err := decodeOrder([]byte(encodeOrder(300000)))
+	if err != nil || n != 300000 || len(rest) != 0 {
+		t.Errorf("Expected order 300000, but got %d, %v", n, err)
+	}
+}
+
+func TestGraph6_Errors(t *testing.T) {
+	if _, err := EncodeGraph6(gograph.New[int](gograph.Directed())); !errors.Is(err, ErrDirectedGraph) {
+		t.Errorf("Expected error %s, but got %v", ErrDirectedGraph, err)
+	}
+
+	g := gograph.New[int]()
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(1))
+	if _, err := EncodeGraph6(g); !errors.Is(err, ErrSelfLoop) {
+		t.Errorf("Expected error %s, but got %v", ErrSelfLoop, err)
+	}
+
+	for _, s := range []string{"", "C", "C~~", "C\x01", "~~"} {
+		if _, err := DecodeGraph6(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, s, err)
+		}
+	}
+}
+
+func TestDecode_TruncatedLargeOrder(t *testing.T) {
+	// the headers declare 2^24 vertices, but the data is missing, so the
+	// decoders fail before they allocate the graph.
+	for _, s := range []string{"~~??~???", "~~??~???ABC", "&~~??~???"} {
+		if _, err := Decode(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, s, err)
+		}
+	}
+}
+
+func TestDigraph6(t *testing.T) {
+	// example of the format description
+	g, err := DecodeDigraph6("&DI?AO?")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	assertEdges(t, g, 5, [2]int{0, 2}, [2]int{0, 4}, [2]int{3, 1}, [2]int{3, 4})
+
+	s, err := EncodeDigraph6(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if s != "&DI?AO?" {
+		t.Errorf("Expected %q, but got %q", "&DI?AO?", s)
+	}
+
+	if _, err = EncodeDigraph6(gograph.New[int]()); !errors.Is(err, ErrUndirectedGraph) {
+		t.Errorf("Expected error %s, but got %v", ErrUndirectedGraph, err)
+	}
+
+	if _, err = DecodeDigraph6("DI?AO?"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Expected error %s, but got %v", ErrInvalidFormat, err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := map[string]bool{"C~": false, ":Fa@x^": false, "&DI?AO?": true, ">>sparse6<<:Fa@x^": false}
+	for s, directed := range tests {
+		g, err := Decode(s)
+		if err != nil {
+			t.Fatalf("Expected no error for %q, but got %s", s, err)
+		}
+
+		if g.IsDirected() != directed {
+			t.Errorf("Expected directed %v for %q", directed, s)
+		}
+	}
+}
+
+func TestReadAll(t *testing.T) {
+	input := "C~\n\n:Fa@x^\n&DI?AO?\n"
+	graphs, err := ReadAll(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(graphs) != 3 {
+		t.Errorf("Expected 3 graphs, but got %d", len(graphs))
+	}
+
+	_, err = ReadAll(strings.NewReader("C~\nC\n"))
+	if !errors.Is(err, ErrInvalidFormat) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected error on line 2, but got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package graph6
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// EncodeSparse6 encodes the undirected graph in the sparse6 format, which
+// is more compact than graph6 for sparse graphs and supports self-loops.
+// The vertices are numbered in the ascending order of their labels.
+//
+// It returns ErrDirectedGraph for directed graphs.
+func EncodeSparse6(g gograph.Graph[int]) (string, error) {
+	if g.IsDirected() {
+		return "", ErrDirectedGraph
+	}
+
+	n, positions := numberVertices(g)
+	k := sparse6Width(n)
+
+	// each edge as (larger endpoint, smaller endpoint), sorted.
+	seen := make(map[[2]int]bool)
+	edges := make([][2]int, 0)
+	for _, edge := range g.AllEdges() {
+		i, j := positions[edge.Source().Label()], positions[edge.Destination().Label()]
+		e := [2]int{max(i, j), min(i, j)}
+		if !seen[e] {
+			seen[e] = true
+			edges = append(edges, e)
+		}
+	}
+
+	sort.Slice(edges, func(a, b int) bool {
+		if edges[a][0] != edges[b][0] {
+			return edges[a][0] < edges[b][0]
+		}
+
+		return edges[a][1] < edges[b][1]
+	})
+
+	bits := newBitWriter()
+	current := 0
+	for _, e := range edges {
+		v, u := e[0], e[1]
+		switch {
+		case v == current:
+			bits.writeBit(false)
+			bits.writeBits(u, k)
+		case v == current+1:
+			current++
+			bits.writeBit(true)
+			bits.writeBits(u, k)
+		default:
+			current = v
+			bits.writeBit(true)
+			bits.writeBits(v, k)
+			bits.writeBit(false)
+			bits.writeBits(u, k)
+		}
+	}
+
+	// when n is a power of two and the last edge ends at vertex n-2, the
+	// padding with ones could be decoded as an extra edge to vertex n-1.
+	// As in nauty, a zero bit before the padding prevents it if there are
+	// at least k+1 padding bits.
+	if n == 1<<k && current == n-2 && bits.padding() >= k+1 {
+		bits.writeBit(false)
+	}
+
+	return string(sparse6Prefix) + encodeOrder(n) + bits.string(1), nil
+}
+
+// DecodeSparse6 decodes a graph in the sparse6 format. The optional
+// ">>sparse6<<" header and the surrounding whitespace are ignored.
+//
+// The vertices of the returned undirected graph are labeled 0..n-1.
+// Since gograph doesn't support parallel edges, repeated edges of a
+// sparse6 multigraph are added once.
+func DecodeSparse6(s string) (gograph.Graph[int], error) {
+	data := []byte(strings.TrimPrefix(strings.TrimSpace(s), sparse6Header))
+	if len(data) == 0 || data[0] != sparse6Prefix {
+		return nil, invalidFormat("missing sparse6 prefix")
+	}
+
+	n, data, err := decodeOrder(data[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := newBitReader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g, vertices := newGraph(n)
+	k := sparse6Width(n)
+
+	v := 0
+	for bits.remaining() >= k+1 {
+		if bits.readBit() {
+			v++
+		}
+
+		x := bits.readBits(k)
+
+		// padding with ones can produce an overlarge number.
+		if x >= n || v >= n {
+			break
+		}
+
+		if x > v {
+			v = x
+			continue
+		}
+
+		if !g.ContainsEdge(vertices[x], vertices[v]) {
+			_, _ = g.AddEdge(vertices[x], vertices[v])
+		}
+	}
+
+	return g, nil
+}
+
+// sparse6Width returns the number of bits needed to represent n-1, which
+// is at least one.
+func sparse6Width(n int) int {
+	k := 1
+	for 1<<k < n {
+		k++
+	}
+
+	return k
+}
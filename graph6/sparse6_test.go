@@ -0,0 +1,114 @@
+package graph6
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestSparse6(t *testing.T) {
+	// example of the format description
+	g, err := DecodeSparse6(":Fa@x^")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	assertEdges(t, g, 7, [2]int{0, 1}, [2]int{0, 2}, [2]int{1, 2}, [2]int{5, 6})
+
+	s, err := EncodeSparse6(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if s != ":Fa@x^" {
+		t.Errorf("Expected %q, but got %q", ":Fa@x^", s)
+	}
+}
+
+func TestSparse6_Padding(t *testing.T) {
+	// canonical nauty strings, where the padding has a leading zero only
+	// if n is a power of two, the last edge ends at n-2, and there are at
+	// least k+1 padding bits.
+	tests := []struct {
+		expected string
+		order    int
+		edges    [][2]int
+	}{
+		{expected: ":An", order: 2, edges: [][2]int{{0, 1}}},
+		{expected: ":Cf", order: 4, edges: [][2]int{{0, 1}}},
+		{expected: ":CcJ", order: 4, edges: [][2]int{{0, 1}, {0, 2}, {1, 2}}},
+		{expected: ":GxV", order: 8, edges: [][2]int{{5, 6}}},
+	}
+
+	for _, tc := range tests {
+		g := gograph.New[int]()
+		for i := 0; i < tc.order; i++ {
+			g.AddVertexByLabel(i)
+		}
+
+		for _, e := range tc.edges {
+			_, _ = g.AddEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+		}
+
+		s, err := EncodeSparse6(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if s != tc.expected {
+			t.Errorf("Expected %q, but got %q", tc.expected, s)
+		}
+	}
+}
+
+func TestSparse6_RoundTrip(t *testing.T) {
+	tests := []struct {
+		order int
+		edges [][2]int
+	}{
+		{order: 0},
+		{order: 1, edges: [][2]int{{0, 0}}},
+		{order: 2, edges: [][2]int{{0, 1}}},
+		{order: 4, edges: [][2]int{{0, 1}}},
+		// n is a power of two and the padding needs a leading zero
+		{order: 4, edges: [][2]int{{0, 1}, {0, 2}, {1, 2}}},
+		{order: 8, edges: [][2]int{{0, 7}, {2, 3}, {3, 3}}},
+		{order: 70, edges: [][2]int{{0, 69}, {10, 20}, {68, 69}}},
+	}
+
+	for _, tc := range tests {
+		g := gograph.New[int]()
+		for i := 0; i < tc.order; i++ {
+			g.AddVertexByLabel(i)
+		}
+
+		for _, e := range tc.edges {
+			_, _ = g.AddEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+		}
+
+		s, err := EncodeSparse6(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		decoded, err := DecodeSparse6(s)
+		if err != nil {
+			t.Fatalf("Expected no error for %q, but got %s", s, err)
+		}
+
+		assertEdges(t, decoded, tc.order, tc.edges...)
+	}
+}
+
+func TestSparse6_Errors(t *testing.T) {
+	if _, err := EncodeSparse6(gograph.New[int](gograph.Directed())); !errors.Is(err, ErrDirectedGraph) {
+		t.Errorf("Expected error %s, but got %v", ErrDirectedGraph, err)
+	}
+
+	for _, s := range []string{"", "Fa@x^", ":", ":~~~~~~~~", ":Fa@x\x01"} {
+		if _, err := DecodeSparse6(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, s, err)
+		}
+	}
+}
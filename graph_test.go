@@ -64,3 +64,31 @@ func TestEdge_OtherVertex(t *testing.T) {
 		t.Errorf("Expect OtherVertex return 1, but get %+v", edge.OtherVertex(2))
 	}
 }
+
+func TestMetadata(t *testing.T) {
+	g := New[string]()
+	vA := g.AddVertexByLabel("A", WithVertexMetadata("first"))
+	if vA.Metadata() != "first" {
+		t.Errorf(testErrMsgNotEqual, "first", vA.Metadata())
+	}
+
+	vB := NewVertex("B", WithVertexWeight(2), WithVertexMetadata(42))
+	if vB.Metadata() != 42 || vB.Weight() != 2 {
+		t.Errorf(testErrMsgNotEqual, 42, vB.Metadata())
+	}
+
+	edge, err := g.AddEdge(vA, vB, WithEdgeMetadata("road"))
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if edge.Metadata() != "road" {
+		t.Errorf(testErrMsgNotEqual, "road", edge.Metadata())
+	}
+
+	// in undirected graph, both directions carry the metadata
+	reverse := g.GetEdge(vB, vA)
+	if reverse == nil || reverse.Metadata() != "road" {
+		t.Errorf(testErrMsgNotEqual, "road", reverse)
+	}
+}
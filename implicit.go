@@ -0,0 +1,52 @@
+package gograph
+
+// Successor is a vertex that an edge of an implicit graph leads to,
+// together with the edge weight.
+type Successor[T comparable] struct {
+	Label  T
+	Weight float64
+}
+
+// Implicit is a graph whose edges are generated on demand, such as the
+// positions of a game, the permutations of a configuration, or the cells
+// of a grid map. The searches that accept an Implicit graph only visit
+// the vertices that they reach, so the graph doesn't have to be built
+// with AddEdge first, and it may even be infinite.
+type Implicit[T comparable] interface {
+	// Successors returns the vertices that the edges from the vertex
+	// with the specified label lead to, with the edge weights.
+	Successors(label T) []Successor[T]
+}
+
+// ImplicitFunc is a function that implements the Implicit interface.
+type ImplicitFunc[T comparable] func(label T) []Successor[T]
+
+// Successors calls the function.
+func (f ImplicitFunc[T]) Successors(label T) []Successor[T] {
+	return f(label)
+}
+
+// AsImplicit returns the Implicit view of the graph. The successors of
+// a vertex are its neighbors, in the order of Vertex.Neighbors, with the
+// edge weights. In unweighted graphs, each edge weighs 1, so the length
+// of a path is its number of edges. Unknown labels have no successors.
+func AsImplicit[T comparable](g Graph[T]) Implicit[T] {
+	return ImplicitFunc[T](func(label T) []Successor[T] {
+		v := g.GetVertexByID(label)
+		if v == nil {
+			return nil
+		}
+
+		successors := make([]Successor[T], 0, len(v.neighbors))
+		for _, neighbor := range v.neighbors {
+			weight := 1.0
+			if g.IsWeighted() {
+				weight = g.GetEdge(v, neighbor).Weight()
+			}
+
+			successors = append(successors, Successor[T]{Label: neighbor.label, Weight: weight})
+		}
+
+		return successors
+	})
+}
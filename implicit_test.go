@@ -0,0 +1,40 @@
+package gograph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAsImplicit(t *testing.T) {
+	weighted := New[string](Directed(), Weighted())
+	_, _ = weighted.AddEdge(NewVertex("A"), NewVertex("B"), WithEdgeWeight(2.5))
+	_, _ = weighted.AddEdge(NewVertex("A"), NewVertex("C"), WithEdgeWeight(4))
+
+	expected := []Successor[string]{{Label: "B", Weight: 2.5}, {Label: "C", Weight: 4}}
+	if got := AsImplicit(weighted).Successors("A"); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected successors %v, but got %v", expected, got)
+	}
+
+	unweighted := New[string]()
+	_, _ = unweighted.AddEdge(NewVertex("A"), NewVertex("B"))
+
+	expected = []Successor[string]{{Label: "A", Weight: 1}}
+	if got := AsImplicit(unweighted).Successors("B"); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected successors %v, but got %v", expected, got)
+	}
+
+	if got := AsImplicit(unweighted).Successors("X"); got != nil {
+		t.Errorf("expected no successors, but got %v", got)
+	}
+}
+
+func TestImplicitFunc(t *testing.T) {
+	var g Implicit[int] = ImplicitFunc[int](func(label int) []Successor[int] {
+		return []Successor[int]{{Label: label * 2, Weight: float64(label)}}
+	})
+
+	expected := []Successor[int]{{Label: 6, Weight: 3}}
+	if got := g.Successors(3); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected successors %v, but got %v", expected, got)
+	}
+}
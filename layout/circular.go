@@ -0,0 +1,30 @@
+package layout
+
+import (
+	"math"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// Circular places the vertices on a circle in the label order, starting
+// at the top and going clockwise. The circle is centered in the drawing
+// area and touches its shorter side.
+func Circular[T comparable](g gograph.Graph[T], options ...OptionFunc) Layout[T] {
+	opts := newOptions(options...)
+	vertices := util.SortedVertices(g)
+
+	center := Point{X: opts.width / 2, Y: opts.height / 2}
+	radius := math.Min(opts.width, opts.height) / 2
+
+	l := make(Layout[T], len(vertices))
+	for i, v := range vertices {
+		angle := 2*math.Pi*float64(i)/float64(len(vertices)) - math.Pi/2
+		l[v.Label()] = Point{
+			X: center.X + radius*math.Cos(angle),
+			Y: center.Y + radius*math.Sin(angle),
+		}
+	}
+
+	return l
+}
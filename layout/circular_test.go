@@ -0,0 +1,30 @@
+package layout
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestCircular(t *testing.T) {
+	g := gograph.New[string]()
+	for _, label := range []string{"D", "B", "A", "C"} {
+		g.AddVertexByLabel(label)
+	}
+
+	l := Circular(g, WithSize(200, 100))
+
+	expected := Layout[string]{
+		"A": {X: 100, Y: 0},
+		"B": {X: 150, Y: 50},
+		"C": {X: 100, Y: 100},
+		"D": {X: 50, Y: 50},
+	}
+
+	for label, p := range expected {
+		if math.Abs(l[label].X-p.X) > 1e-9 || math.Abs(l[label].Y-p.Y) > 1e-9 {
+			t.Errorf("Expected %s at %v, but got %v", label, p, l[label])
+		}
+	}
+}
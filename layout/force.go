@@ -0,0 +1,233 @@
+package layout
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// minDistance avoids the division by zero when two vertices overlap.
+const minDistance = 0.01
+
+// FruchtermanReingold computes a force-directed layout, where all the
+// vertices repel each other and the edges pull their endpoints together
+// like springs. The vertices start at random positions, and the maximum
+// displacement of each iteration cools down linearly, so the layout
+// settles inside the drawing area.
+//
+// The edge direction is ignored. In weighted graphs, positive weights
+// scale the attraction of the edges.
+func FruchtermanReingold[T comparable](g gograph.Graph[T], options ...OptionFunc) Layout[T] {
+	opts := newOptions(options...)
+	idx := newIndex(g)
+	n := len(idx.labels)
+	if n == 0 {
+		return Layout[T]{}
+	}
+
+	positions := randomPositions(n, opts)
+	k := math.Sqrt(opts.width * opts.height / float64(n))
+	temperature := opts.width / 10
+	cooling := temperature / float64(opts.iterations+1)
+
+	disp := make([]Point, n)
+	for iteration := 0; iteration < opts.iterations; iteration++ {
+		for i := range disp {
+			disp[i] = Point{}
+		}
+
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				dx, dy, d := distance(positions[i], positions[j])
+				f := k * k / d
+				disp[i].X += dx / d * f
+				disp[i].Y += dy / d * f
+				disp[j].X -= dx / d * f
+				disp[j].Y -= dy / d * f
+			}
+		}
+
+		for _, e := range idx.edges {
+			dx, dy, d := distance(positions[e.i], positions[e.j])
+			f := d * d / k * e.weight
+			disp[e.i].X -= dx / d * f
+			disp[e.i].Y -= dy / d * f
+			disp[e.j].X += dx / d * f
+			disp[e.j].Y += dy / d * f
+		}
+
+		for i := range positions {
+			length := math.Hypot(disp[i].X, disp[i].Y)
+			if length < minDistance {
+				continue
+			}
+
+			step := math.Min(length, temperature)
+			positions[i].X = clamp(positions[i].X+disp[i].X/length*step, 0, opts.width)
+			positions[i].Y = clamp(positions[i].Y+disp[i].Y/length*step, 0, opts.height)
+		}
+
+		temperature -= cooling
+	}
+
+	return idx.layout(positions)
+}
+
+// ForceAtlas2 computes a force-directed layout with the ForceAtlas2
+// algorithm of Gephi. The repulsion is proportional to the degrees of
+// the vertices, so hubs push the other vertices away and the clusters
+// become visible. A gravity force keeps the disconnected components
+// together, and an adaptive speed of each vertex, based on how much it
+// swings between the iterations, makes the layout converge.
+//
+// The edge direction is ignored. In weighted graphs, positive weights
+// scale the attraction of the edges. The final layout is scaled to fit
+// the drawing area.
+func ForceAtlas2[T comparable](g gograph.Graph[T], options ...OptionFunc) Layout[T] {
+	const (
+		scaling   = 2.0
+		tolerance = 1.0
+		maxSpeed  = 10.0
+	)
+
+	opts := newOptions(options...)
+	idx := newIndex(g)
+	n := len(idx.labels)
+	if n == 0 {
+		return Layout[T]{}
+	}
+
+	positions := randomPositions(n, opts)
+	for i := range positions {
+		// centered at the origin, where the gravity pulls.
+		positions[i].X -= opts.width / 2
+		positions[i].Y -= opts.height / 2
+	}
+
+	mass := make([]float64, n)
+	for i := range mass {
+		mass[i] = float64(idx.degree[i] + 1)
+	}
+
+	forces := make([]Point, n)
+	previous := make([]Point, n)
+	speed := 1.0
+	for iteration := 0; iteration < opts.iterations; iteration++ {
+		copy(previous, forces)
+		for i := range forces {
+			forces[i] = Point{}
+		}
+
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				dx, dy, d := distance(positions[i], positions[j])
+				f := scaling * mass[i] * mass[j] / d
+				forces[i].X += dx / d * f
+				forces[i].Y += dy / d * f
+				forces[j].X -= dx / d * f
+				forces[j].Y -= dy / d * f
+			}
+		}
+
+		for i := range positions {
+			d := math.Max(math.Hypot(positions[i].X, positions[i].Y), minDistance)
+			f := opts.gravity * mass[i] / d
+			forces[i].X -= positions[i].X * f
+			forces[i].Y -= positions[i].Y * f
+		}
+
+		for _, e := range idx.edges {
+			dx, dy, _ := distance(positions[e.i], positions[e.j])
+			forces[e.i].X -= dx * e.weight
+			forces[e.i].Y -= dy * e.weight
+			forces[e.j].X += dx * e.weight
+			forces[e.j].Y += dy * e.weight
+		}
+
+		if iteration == 0 {
+			copy(previous, forces)
+		}
+
+		// the global speed grows while the vertices move steadily, and
+		// shrinks when they oscillate.
+		swings := make([]float64, n)
+		var swing, traction float64
+		for i := range forces {
+			swings[i] = math.Hypot(forces[i].X-previous[i].X, forces[i].Y-previous[i].Y)
+			swing += mass[i] * swings[i]
+			traction += mass[i] * math.Hypot(forces[i].X+previous[i].X, forces[i].Y+previous[i].Y) / 2
+		}
+
+		if swing > 0 {
+			speed = math.Min(tolerance*traction/swing, 1.5*speed)
+		}
+
+		for i := range positions {
+			localSpeed := speed / (1 + speed*math.Sqrt(swings[i]))
+			force := math.Hypot(forces[i].X, forces[i].Y)
+			if force > 0 {
+				localSpeed = math.Min(localSpeed, maxSpeed/force)
+			}
+
+			positions[i].X += forces[i].X * localSpeed
+			positions[i].Y += forces[i].Y * localSpeed
+		}
+	}
+
+	fit(positions, opts.width, opts.height)
+	return idx.layout(positions)
+}
+
+// randomPositions returns n random positions inside the drawing area.
+func randomPositions(n int, opts *Options) []Point {
+	r := rand.New(rand.NewSource(opts.seed))
+	positions := make([]Point, n)
+	for i := range positions {
+		positions[i] = Point{X: r.Float64() * opts.width, Y: r.Float64() * opts.height}
+	}
+
+	return positions
+}
+
+// distance returns the vector from q to p and its length, which is at
+// least minDistance.
+func distance(p, q Point) (float64, float64, float64) {
+	dx, dy := p.X-q.X, p.Y-q.Y
+	return dx, dy, math.Max(math.Hypot(dx, dy), minDistance)
+}
+
+// fit scales and moves the positions to fit the drawing area, keeping
+// the aspect ratio. A single position is moved to the center.
+func fit(positions []Point, width, height float64) {
+	minP := Point{X: math.Inf(1), Y: math.Inf(1)}
+	maxP := Point{X: math.Inf(-1), Y: math.Inf(-1)}
+	for _, p := range positions {
+		minP.X, minP.Y = math.Min(minP.X, p.X), math.Min(minP.Y, p.Y)
+		maxP.X, maxP.Y = math.Max(maxP.X, p.X), math.Max(maxP.Y, p.Y)
+	}
+
+	scale := math.Inf(1)
+	if maxP.X > minP.X {
+		scale = width / (maxP.X - minP.X)
+	}
+
+	if maxP.Y > minP.Y {
+		scale = math.Min(scale, height/(maxP.Y-minP.Y))
+	}
+
+	if math.IsInf(scale, 1) {
+		scale = 0
+	}
+
+	for i, p := range positions {
+		positions[i] = Point{
+			X: (p.X-(minP.X+maxP.X)/2)*scale + width/2,
+			Y: (p.Y-(minP.Y+maxP.Y)/2)*scale + height/2,
+		}
+	}
+}
+
+func clamp(x, low, high float64) float64 {
+	return math.Max(low, math.Min(high, x))
+}
@@ -0,0 +1,77 @@
+package layout
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// twoTriangles returns two triangles connected by the edge 3 - 4.
+func twoTriangles() gograph.Graph[int] {
+	g := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {1, 3}, {4, 5}, {5, 6}, {4, 6}, {3, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	return g
+}
+
+func dist(p, q Point) float64 {
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+func testForceLayout(t *testing.T, layout func(gograph.Graph[int], ...OptionFunc) Layout[int]) {
+	g := twoTriangles()
+
+	l := layout(g, WithSize(500, 400), WithSeed(7))
+	if len(l) != 6 {
+		t.Fatalf("Expected 6 positions, but got %d", len(l))
+	}
+
+	for label, p := range l {
+		if p.X < 0 || p.X > 500 || p.Y < 0 || p.Y > 400 || math.IsNaN(p.X) || math.IsNaN(p.Y) {
+			t.Errorf("Expected vertex %d inside the drawing area, but got %v", label, p)
+		}
+	}
+
+	// vertices of the same triangle are closer than the far vertices of
+	// the other triangle.
+	if dist(l[1], l[2]) >= dist(l[1], l[6]) || dist(l[5], l[6]) >= dist(l[2], l[6]) {
+		t.Errorf("Expected the triangles to be clustered, but got %v", l)
+	}
+
+	again := layout(g, WithSize(500, 400), WithSeed(7))
+	for label, p := range l {
+		if again[label] != p {
+			t.Errorf("Expected the same layout for the same seed, but got %v and %v", p, again[label])
+		}
+	}
+
+	if l := layout(gograph.New[int]()); len(l) != 0 {
+		t.Errorf("Expected empty layout, but got %v", l)
+	}
+
+	single := gograph.New[int]()
+	single.AddVertexByLabel(1)
+	if l := layout(single, WithSize(100, 100)); l[1].X < 0 || l[1].X > 100 {
+		t.Errorf("Expected the vertex inside the drawing area, but got %v", l[1])
+	}
+}
+
+func TestFruchtermanReingold(t *testing.T) {
+	testForceLayout(t, FruchtermanReingold[int])
+}
+
+func TestForceAtlas2(t *testing.T) {
+	testForceLayout(t, ForceAtlas2[int])
+
+	// gravity keeps the disconnected vertices in the drawing area.
+	g := gograph.New[int]()
+	g.AddVertexByLabel(1)
+	g.AddVertexByLabel(2)
+	l := ForceAtlas2(g, WithSize(100, 100), WithGravity(5), WithIterations(50))
+	if dist(l[1], l[2]) == 0 {
+		t.Errorf("Expected distinct positions, but got %v", l)
+	}
+}
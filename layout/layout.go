@@ -0,0 +1,103 @@
+package layout
+
+import (
+	"errors"
+	"math"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+var (
+	ErrNotDAG          = errors.New("layered layout requires a directed acyclic graph")
+	ErrMissingPosition = errors.New("vertex has no position in the layout")
+)
+
+// Point is a position in the drawing plane. The y axis points down, as
+// in SVG.
+type Point struct {
+	X, Y float64
+}
+
+// Layout maps each vertex label to its position.
+type Layout[T comparable] map[T]Point
+
+// Bounds returns the top-left and the bottom-right corners of the
+// smallest rectangle that contains all the positions. It returns two
+// zero points for an empty layout.
+func (l Layout[T]) Bounds() (Point, Point) {
+	if len(l) == 0 {
+		return Point{}, Point{}
+	}
+
+	minP := Point{X: math.Inf(1), Y: math.Inf(1)}
+	maxP := Point{X: math.Inf(-1), Y: math.Inf(-1)}
+	for _, p := range l {
+		minP.X, minP.Y = math.Min(minP.X, p.X), math.Min(minP.Y, p.Y)
+		maxP.X, maxP.Y = math.Max(maxP.X, p.X), math.Max(maxP.Y, p.Y)
+	}
+
+	return minP, maxP
+}
+
+// edge is an undirected edge between two vertex indexes with its weight.
+type edge struct {
+	i, j   int
+	weight float64
+}
+
+// index numbers the vertices in the label order, and lists each edge once
+// ignoring the direction, so the force-directed layouts iterate in a
+// stable order and give the same result for the same seed.
+type index[T comparable] struct {
+	labels []T
+	edges  []edge
+	degree []int
+}
+
+func newIndex[T comparable](g gograph.Graph[T]) *index[T] {
+	vertices := util.SortedVertices(g)
+	idx := &index[T]{
+		labels: make([]T, len(vertices)),
+		degree: make([]int, len(vertices)),
+	}
+
+	positions := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		idx.labels[i] = v.Label()
+		positions[v.Label()] = i
+	}
+
+	seen := make(map[[2]int]bool)
+	for i, v := range vertices {
+		for _, neighbor := range v.Neighbors() {
+			j := positions[neighbor.Label()]
+			key := [2]int{min(i, j), max(i, j)}
+			if i == j || seen[key] {
+				continue
+			}
+
+			seen[key] = true
+
+			weight := 1.0
+			if e := g.GetEdge(v, neighbor); g.IsWeighted() && e != nil && e.Weight() > 0 {
+				weight = e.Weight()
+			}
+
+			idx.edges = append(idx.edges, edge{i: key[0], j: key[1], weight: weight})
+			idx.degree[i]++
+			idx.degree[j]++
+		}
+	}
+
+	return idx
+}
+
+func (idx *index[T]) layout(positions []Point) Layout[T] {
+	l := make(Layout[T], len(positions))
+	for i, p := range positions {
+		l[idx.labels[i]] = p
+	}
+
+	return l
+}
@@ -0,0 +1,52 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestLayout_Bounds(t *testing.T) {
+	l := Layout[string]{"A": {X: 1, Y: 5}, "B": {X: -2, Y: 3}, "C": {X: 4, Y: 0}}
+
+	minP, maxP := l.Bounds()
+	if minP != (Point{X: -2, Y: 0}) || maxP != (Point{X: 4, Y: 5}) {
+		t.Errorf("Expected bounds (-2, 0) (4, 5), but got %v %v", minP, maxP)
+	}
+
+	minP, maxP = Layout[string]{}.Bounds()
+	if minP != (Point{}) || maxP != (Point{}) {
+		t.Errorf("Expected zero bounds, but got %v %v", minP, maxP)
+	}
+}
+
+func TestNewIndex(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_, _ = g.AddEdge(v2, v1, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(v2, v3, gograph.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(v3, v3)
+
+	idx := newIndex(g)
+	if len(idx.labels) != 3 || idx.labels[0] != 1 || idx.labels[2] != 3 {
+		t.Errorf("Expected sorted labels, but got %v", idx.labels)
+	}
+
+	// each edge once, without the self-loop, and non-positive weights as 1.
+	expected := []edge{{i: 0, j: 1, weight: 3}, {i: 1, j: 2, weight: 1}}
+	if len(idx.edges) != len(expected) {
+		t.Fatalf("Expected edges %v, but got %v", expected, idx.edges)
+	}
+
+	for i := range expected {
+		if idx.edges[i] != expected[i] {
+			t.Errorf("Expected edges %v, but got %v", expected, idx.edges)
+		}
+	}
+
+	if idx.degree[1] != 2 {
+		t.Errorf("Expected degree 2, but got %d", idx.degree[1])
+	}
+}
@@ -0,0 +1,78 @@
+package layout
+
+// OptionFunc represent an alias of function type that
+// modifies the specified layout options.
+type OptionFunc func(options *Options)
+
+// Options represents the options of the layout algorithms.
+type Options struct {
+	width        float64
+	height       float64
+	iterations   int
+	seed         int64
+	gravity      float64
+	layerSpacing float64
+	nodeSpacing  float64
+}
+
+// WithSize sets the size of the drawing area of the force-directed and
+// circular layouts. The default size is 1000 x 1000.
+func WithSize(width, height float64) OptionFunc {
+	return func(options *Options) {
+		options.width = width
+		options.height = height
+	}
+}
+
+// WithIterations sets the number of iterations of the force-directed
+// layouts. The default is 300.
+func WithIterations(iterations int) OptionFunc {
+	return func(options *Options) {
+		options.iterations = iterations
+	}
+}
+
+// WithSeed sets the seed of the random initial positions of the
+// force-directed layouts. The same seed gives the same layout.
+func WithSeed(seed int64) OptionFunc {
+	return func(options *Options) {
+		options.seed = seed
+	}
+}
+
+// WithGravity sets the strength of the force that pulls the vertices of
+// ForceAtlas2 towards the center, which keeps the disconnected components
+// together. The default is 1.
+func WithGravity(gravity float64) OptionFunc {
+	return func(options *Options) {
+		options.gravity = gravity
+	}
+}
+
+// WithSpacing sets the distance between the layers and the distance
+// between the vertices of a layer in the Sugiyama layout. The default
+// spacing is 100.
+func WithSpacing(layer, node float64) OptionFunc {
+	return func(options *Options) {
+		options.layerSpacing = layer
+		options.nodeSpacing = node
+	}
+}
+
+func newOptions(options ...OptionFunc) *Options {
+	opts := &Options{
+		width:        1000,
+		height:       1000,
+		iterations:   300,
+		seed:         1,
+		gravity:      1,
+		layerSpacing: 100,
+		nodeSpacing:  100,
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
@@ -0,0 +1,218 @@
+package layout
+
+import (
+	"sort"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// sweeps is the number of the down and up sweeps of the crossing reduction.
+const sweeps = 12
+
+// Sugiyama computes a layered layout of a directed acyclic graph, where
+// all the edges point down:
+//
+//  1. Layering: each vertex is placed on the layer after its deepest
+//     predecessor, following the order of gograph.TopologySort.
+//  2. Edges that span more than one layer get a dummy vertex on each
+//     layer in between.
+//  3. Crossing reduction: the vertices of each layer are ordered by the
+//     barycenter of their neighbors in the previous layer, sweeping down
+//     and up, and the order with the fewest crossings is kept.
+//  4. Coordinates: the layers are spaced by the layer spacing, and the
+//     vertices of each layer are spaced by the node spacing and centered.
+//
+// It returns ErrNotDAG if the graph is undirected or has a cycle.
+func Sugiyama[T comparable](g gograph.Graph[T], options ...OptionFunc) (Layout[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDAG
+	}
+
+	sorted, err := gograph.TopologySort(g)
+	if err != nil {
+		return nil, ErrNotDAG
+	}
+
+	opts := newOptions(options...)
+
+	rank := make(map[T]int, len(sorted))
+	for _, v := range sorted {
+		for _, neighbor := range v.Neighbors() {
+			rank[neighbor.Label()] = max(rank[neighbor.Label()], rank[v.Label()]+1)
+		}
+	}
+
+	h := newHierarchy(g, rank)
+	h.reduceCrossings()
+
+	width := 0
+	for _, layer := range h.layers {
+		width = max(width, len(layer))
+	}
+
+	l := make(Layout[T], len(sorted))
+	for depth, layer := range h.layers {
+		offset := float64(width-len(layer)) / 2
+		for i, node := range layer {
+			if node < len(h.labels) {
+				l[h.labels[node]] = Point{
+					X: (offset + float64(i)) * opts.nodeSpacing,
+					Y: float64(depth) * opts.layerSpacing,
+				}
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// hierarchy is a layered graph. The nodes 0..len(labels)-1 are the
+// vertices, and the rest are dummy nodes of the long edges.
+type hierarchy[T comparable] struct {
+	labels []T
+	layers [][]int
+	up     [][]int // neighbors of each node in the previous layer
+	down   [][]int // neighbors of each node in the next layer
+}
+
+func newHierarchy[T comparable](g gograph.Graph[T], rank map[T]int) *hierarchy[T] {
+	vertices := util.SortedVertices(g)
+	h := &hierarchy[T]{labels: make([]T, len(vertices))}
+
+	nodes := make(map[T]int, len(vertices))
+	layerOf := make([]int, 0, len(vertices))
+	addNode := func(layer int) int {
+		for len(h.layers) <= layer {
+			h.layers = append(h.layers, nil)
+		}
+
+		node := len(h.up)
+		h.layers[layer] = append(h.layers[layer], node)
+		h.up = append(h.up, nil)
+		h.down = append(h.down, nil)
+		layerOf = append(layerOf, layer)
+		return node
+	}
+
+	for i, v := range vertices {
+		h.labels[i] = v.Label()
+		nodes[v.Label()] = addNode(rank[v.Label()])
+	}
+
+	link := func(from, to int) {
+		h.down[from] = append(h.down[from], to)
+		h.up[to] = append(h.up[to], from)
+	}
+
+	for _, v := range vertices {
+		neighbors := v.Neighbors()
+		sort.Slice(neighbors, func(i, j int) bool {
+			return util.CompareLabels(neighbors[i].Label(), neighbors[j].Label()) < 0
+		})
+
+		for _, neighbor := range neighbors {
+			from, to := nodes[v.Label()], nodes[neighbor.Label()]
+			for layer := layerOf[from] + 1; layer < layerOf[to]; layer++ {
+				dummy := addNode(layer)
+				link(from, dummy)
+				from = dummy
+			}
+
+			link(from, to)
+		}
+	}
+
+	return h
+}
+
+// reduceCrossings orders the layers with the barycenter heuristic.
+func (h *hierarchy[T]) reduceCrossings() {
+	best := h.copyLayers()
+	bestCrossings := h.crossings()
+
+	for sweep := 0; sweep < sweeps && bestCrossings > 0; sweep++ {
+		if sweep%2 == 0 {
+			for i := 1; i < len(h.layers); i++ {
+				h.orderByBarycenter(h.layers[i], h.layers[i-1], h.up)
+			}
+		} else {
+			for i := len(h.layers) - 2; i >= 0; i-- {
+				h.orderByBarycenter(h.layers[i], h.layers[i+1], h.down)
+			}
+		}
+
+		if crossings := h.crossings(); crossings < bestCrossings {
+			best, bestCrossings = h.copyLayers(), crossings
+		}
+	}
+
+	h.layers = best
+}
+
+// orderByBarycenter sorts the layer by the average position of the
+// neighbors of each node in the fixed layer. Nodes without neighbors
+// keep their position.
+func (h *hierarchy[T]) orderByBarycenter(layer, fixed []int, neighbors [][]int) {
+	positions := make(map[int]int, len(fixed))
+	for i, node := range fixed {
+		positions[node] = i
+	}
+
+	barycenters := make(map[int]float64, len(layer))
+	for i, node := range layer {
+		barycenters[node] = float64(i)
+		if len(neighbors[node]) == 0 {
+			continue
+		}
+
+		sum := 0
+		for _, neighbor := range neighbors[node] {
+			sum += positions[neighbor]
+		}
+
+		barycenters[node] = float64(sum) / float64(len(neighbors[node]))
+	}
+
+	sort.SliceStable(layer, func(i, j int) bool {
+		return barycenters[layer[i]] < barycenters[layer[j]]
+	})
+}
+
+// crossings counts the edge crossings between all the adjacent layers.
+func (h *hierarchy[T]) crossings() int {
+	count := 0
+	for i := 0; i+1 < len(h.layers); i++ {
+		positions := make(map[int]int, len(h.layers[i+1]))
+		for p, node := range h.layers[i+1] {
+			positions[node] = p
+		}
+
+		// edges as (upper position, lower position) in the upper order.
+		var edges [][2]int
+		for p, node := range h.layers[i] {
+			for _, neighbor := range h.down[node] {
+				edges = append(edges, [2]int{p, positions[neighbor]})
+			}
+		}
+
+		for a := 0; a < len(edges); a++ {
+			for b := a + 1; b < len(edges); b++ {
+				if (edges[a][0]-edges[b][0])*(edges[a][1]-edges[b][1]) < 0 {
+					count++
+				}
+			}
+		}
+	}
+
+	return count
+}
+
+func (h *hierarchy[T]) copyLayers() [][]int {
+	layers := make([][]int, len(h.layers))
+	for i, layer := range h.layers {
+		layers[i] = append([]int(nil), layer...)
+	}
+
+	return layers
+}
@@ -0,0 +1,96 @@
+package layout
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestSugiyama(t *testing.T) {
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "C"}, {"A", "D"}, {"B", "C"}, {"C", "E"}, {"A", "E"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	l, err := Sugiyama(g, WithSpacing(50, 40))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	layers := map[string]float64{"A": 0, "B": 0, "C": 50, "D": 50, "E": 100}
+	for label, y := range layers {
+		if l[label].Y != y {
+			t.Errorf("Expected %s on y %f, but got %v", label, y, l[label])
+		}
+	}
+
+	// all edges point down.
+	for _, e := range g.AllEdges() {
+		if l[e.Source().Label()].Y >= l[e.Destination().Label()].Y {
+			t.Errorf("Expected edge %s -> %s to point down", e.Source().Label(), e.Destination().Label())
+		}
+	}
+
+	// vertices of a layer are spaced by the node spacing, and the dummy
+	// node of the edge A -> E takes a place in the middle layer.
+	if d := math.Abs(l["C"].X - l["D"].X); d != 40 && d != 80 {
+		t.Errorf("Expected C and D 40 or 80 apart, but got %v %v", l["C"], l["D"])
+	}
+}
+
+func TestSugiyama_ReduceCrossings(t *testing.T) {
+	// in the label order, the edges A -> Z and B -> Y cross.
+	g := gograph.New[string](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("Z"))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("Y"))
+
+	l, err := Sugiyama(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if (l["A"].X < l["B"].X) != (l["Z"].X < l["Y"].X) {
+		t.Errorf("Expected no crossing, but got %v", l)
+	}
+}
+
+func TestSugiyama_LongEdges(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v1, v3)
+
+	h := newHierarchy(g, map[int]int{1: 0, 2: 1, 3: 2})
+	if len(h.layers[1]) != 2 {
+		t.Errorf("Expected a dummy node in the middle layer, but got %v", h.layers)
+	}
+
+	l, err := Sugiyama(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(l) != 3 {
+		t.Errorf("Expected no position for the dummy nodes, but got %v", l)
+	}
+}
+
+func TestSugiyama_NotDAG(t *testing.T) {
+	undirected := gograph.New[int]()
+	undirected.AddVertexByLabel(1)
+	if _, err := Sugiyama(undirected); !errors.Is(err, ErrNotDAG) {
+		t.Errorf("Expected error %s, but got %v", ErrNotDAG, err)
+	}
+
+	cyclic := gograph.New[int](gograph.Directed())
+	_, _ = cyclic.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = cyclic.AddEdge(gograph.NewVertex(2), gograph.NewVertex(1))
+	if _, err := Sugiyama(cyclic); !errors.Is(err, ErrNotDAG) {
+		t.Errorf("Expected error %s, but got %v", ErrNotDAG, err)
+	}
+}
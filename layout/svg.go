@@ -0,0 +1,198 @@
+package layout
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// SVGOptionFunc represent an alias of function type that
+// modifies the specified SVG options.
+type SVGOptionFunc[T comparable] func(options *SVGOptions[T])
+
+// SVGOptions represents the options of WriteSVG.
+type SVGOptions[T comparable] struct {
+	nodeRadius float64
+	nodeLabel  func(v *gograph.Vertex[T]) string
+	edgeLabel  func(e *gograph.Edge[T]) string
+}
+
+// WithNodeRadius sets the radius of the vertex circles. The default
+// radius is 12.
+func WithNodeRadius[T comparable](radius float64) SVGOptionFunc[T] {
+	return func(options *SVGOptions[T]) {
+		options.nodeRadius = radius
+	}
+}
+
+// WithNodeLabel sets the function that returns the text of each vertex.
+//
+// By default, the text is the vertex metadata if it is a string or a
+// fmt.Stringer, and the vertex label otherwise.
+func WithNodeLabel[T comparable](f func(v *gograph.Vertex[T]) string) SVGOptionFunc[T] {
+	return func(options *SVGOptions[T]) {
+		options.nodeLabel = f
+	}
+}
+
+// WithEdgeLabel sets the function that returns the text of each edge.
+// An empty text draws the edge without a label.
+//
+// By default, edges of weighted graphs are labeled by their weights, and
+// the edges of unweighted graphs have no label.
+func WithEdgeLabel[T comparable](f func(e *gograph.Edge[T]) string) SVGOptionFunc[T] {
+	return func(options *SVGOptions[T]) {
+		options.edgeLabel = f
+	}
+}
+
+// WriteSVG draws the graph with the positions of the layout as an SVG
+// image, without any external tool. Vertices are drawn as labeled
+// circles and edges as lines between them, with arrows in directed
+// graphs and the weights in the middle in weighted graphs. In undirected
+// graphs, each edge is drawn once.
+//
+// The image is sized to the bounds of the layout plus a margin. It
+// returns ErrMissingPosition if a vertex has no position in the layout.
+func WriteSVG[T comparable](w io.Writer, g gograph.Graph[T], l Layout[T], options ...SVGOptionFunc[T]) error {
+	opts := &SVGOptions[T]{
+		nodeRadius: 12,
+		nodeLabel:  util.VertexText[T],
+		edgeLabel: func(e *gograph.Edge[T]) string {
+			if g.IsWeighted() {
+				return strconv.FormatFloat(e.Weight(), 'g', -1, 64)
+			}
+
+			return ""
+		},
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	vertices := util.SortedVertices(g)
+	for _, v := range vertices {
+		if _, ok := l[v.Label()]; !ok {
+			return fmt.Errorf("%w: %v", ErrMissingPosition, v.Label())
+		}
+	}
+
+	r := opts.nodeRadius
+	margin := 2*r + 10
+	minP, maxP := l.Bounds()
+	width, height := maxP.X-minP.X+2*margin, maxP.Y-minP.Y+2*margin
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(
+		bw,
+		"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%s\" height=\"%s\" viewBox=\"%s %s %s %s\">\n",
+		num(width), num(height), num(minP.X-margin), num(minP.Y-margin), num(width), num(height),
+	)
+
+	if g.IsDirected() {
+		_, _ = fmt.Fprintln(bw, `  <defs>`)
+		_, _ = fmt.Fprintln(bw, `    <marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto">`)
+		_, _ = fmt.Fprintln(bw, `      <path d="M 0 0 L 10 5 L 0 10 z" fill="#555"/>`)
+		_, _ = fmt.Fprintln(bw, `    </marker>`)
+		_, _ = fmt.Fprintln(bw, `  </defs>`)
+	}
+
+	marker := ""
+	if g.IsDirected() {
+		marker = ` marker-end="url(#arrow)"`
+	}
+
+	var labels []string
+	_, _ = fmt.Fprintln(bw, `  <g fill="none" stroke="#555" stroke-width="1.5">`)
+	for _, v := range vertices {
+		for _, e := range outgoingEdges(g, v) {
+			p, q := l[e.Source().Label()], l[e.Destination().Label()]
+			if !g.IsDirected() && util.CompareLabels(e.Source().Label(), e.Destination().Label()) > 0 {
+				continue
+			}
+
+			var mid Point
+			if e.Source().Label() == e.Destination().Label() {
+				// a self-loop is a small circle above the vertex.
+				_, _ = fmt.Fprintf(bw, "    <circle cx=\"%s\" cy=\"%s\" r=\"%s\"/>\n", num(p.X), num(p.Y-r), num(r))
+				mid = Point{X: p.X, Y: p.Y - 2*r}
+			} else {
+				dx, dy, d := distance(q, p)
+				_, _ = fmt.Fprintf(
+					bw, "    <line x1=\"%s\" y1=\"%s\" x2=\"%s\" y2=\"%s\"%s/>\n",
+					num(p.X+dx/d*r), num(p.Y+dy/d*r), num(q.X-dx/d*r), num(q.Y-dy/d*r), marker,
+				)
+				mid = Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+			}
+
+			if label := opts.edgeLabel(e); label != "" {
+				labels = append(labels, fmt.Sprintf(
+					"    <text x=\"%s\" y=\"%s\" dy=\"-4\">%s</text>", num(mid.X), num(mid.Y), html.EscapeString(label),
+				))
+			}
+		}
+	}
+	_, _ = fmt.Fprintln(bw, `  </g>`)
+
+	if len(labels) > 0 {
+		_, _ = fmt.Fprintln(bw, `  <g font-family="sans-serif" font-size="11" fill="#a33" text-anchor="middle">`)
+		for _, label := range labels {
+			_, _ = fmt.Fprintln(bw, label)
+		}
+		_, _ = fmt.Fprintln(bw, `  </g>`)
+	}
+
+	_, _ = fmt.Fprintln(bw, `  <g font-family="sans-serif" font-size="12" text-anchor="middle">`)
+	for _, v := range vertices {
+		p := l[v.Label()]
+		_, _ = fmt.Fprintf(
+			bw, "    <circle cx=\"%s\" cy=\"%s\" r=\"%s\" fill=\"#fff\" stroke=\"#333\" stroke-width=\"1.5\"/>\n",
+			num(p.X), num(p.Y), num(r),
+		)
+		_, _ = fmt.Fprintf(
+			bw, "    <text x=\"%s\" y=\"%s\" dy=\"0.35em\">%s</text>\n",
+			num(p.X), num(p.Y), html.EscapeString(opts.nodeLabel(v)),
+		)
+	}
+	_, _ = fmt.Fprintln(bw, `  </g>`)
+	_, _ = fmt.Fprintln(bw, `</svg>`)
+
+	return bw.Flush()
+}
+
+// outgoingEdges returns the edges from the vertex in the label order of
+// their destinations.
+func outgoingEdges[T comparable](g gograph.Graph[T], v *gograph.Vertex[T]) []*gograph.Edge[T] {
+	neighbors := v.Neighbors()
+	labels := make([]T, 0, len(neighbors))
+	seen := make(map[T]bool, len(neighbors))
+	for _, neighbor := range neighbors {
+		if !seen[neighbor.Label()] {
+			seen[neighbor.Label()] = true
+			labels = append(labels, neighbor.Label())
+		}
+	}
+
+	util.SortLabels(labels)
+
+	edges := make([]*gograph.Edge[T], 0, len(labels))
+	for _, label := range labels {
+		if e := g.GetEdge(v, g.GetVertexByID(label)); e != nil {
+			edges = append(edges, e)
+		}
+	}
+
+	return edges
+}
+
+// num formats the coordinate with at most two decimals.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,73 @@
+package layout
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestWriteSVG(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	vA := g.AddVertexByLabel("A", gograph.WithVertexMetadata("a <b>"))
+	vB := g.AddVertexByLabel("B")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(2.5))
+
+	l := Layout[string]{"A": {X: 0, Y: 0}, "B": {X: 100, Y: 0}}
+
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, g, l, WithNodeRadius[string](10)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	out := buf.String()
+	expected := []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" width="160" height="60" viewBox="-30 -30 160 60">`,
+		`<marker id="arrow"`,
+		`<line x1="10" y1="0" x2="90" y2="0" marker-end="url(#arrow)"/>`,
+		`<text x="50" y="0" dy="-4">2.5</text>`,
+		`<circle cx="0" cy="0" r="10"`,
+		`>a &lt;b&gt;</text>`,
+		`>B</text>`,
+	}
+
+	for _, s := range expected {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected output to contain %q, but got:\n%s", s, out)
+		}
+	}
+}
+
+func TestWriteSVG_Undirected(t *testing.T) {
+	g := gograph.New[int]()
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(2))
+
+	var buf bytes.Buffer
+	err := WriteSVG(&buf, g, Circular(g), WithEdgeLabel(func(e *gograph.Edge[int]) string { return "" }))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	out := buf.String()
+	if strings.Count(out, "<line") != 1 || strings.Contains(out, "marker") {
+		t.Errorf("Expected one line without arrows, but got:\n%s", out)
+	}
+
+	// the self-loop circle and the two vertices.
+	if strings.Count(out, "<circle") != 3 {
+		t.Errorf("Expected three circles, but got:\n%s", out)
+	}
+}
+
+func TestWriteSVG_MissingPosition(t *testing.T) {
+	g := gograph.New[int]()
+	g.AddVertexByLabel(1)
+
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, g, Layout[int]{}); !errors.Is(err, ErrMissingPosition) {
+		t.Errorf("Expected error %s, but got %v", ErrMissingPosition, err)
+	}
+}
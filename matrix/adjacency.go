@@ -0,0 +1,174 @@
+package matrix
+
+import (
+	"sort"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// Adjacency returns the dense adjacency matrix of the graph. The element
+// at row i and column j is the weight of the edge from the vertex at
+// position i to the vertex at position j of the index, or 1 if the graph
+// is not weighted. Missing edges are zero.
+//
+// Undirected graphs produce a symmetric matrix.
+//
+// It returns ErrLabelNotIndexed if the index doesn't contain all the
+// vertices of the graph.
+func Adjacency[T comparable](g gograph.Graph[T], idx *Index[T]) (*Dense, error) {
+	coo, err := AdjacencyCOO(g, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return coo.ToDense()
+}
+
+// AdjacencyCOO returns the adjacency matrix of the graph in the
+// coordinate format. See Adjacency for the meaning of the elements.
+//
+// The triples are ordered by row and then by column.
+func AdjacencyCOO[T comparable](g gograph.Graph[T], idx *Index[T]) (*COO, error) {
+	if err := idx.covers(g); err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		i, j  int
+		value float64
+	}
+
+	edges := g.AllEdges()
+	entries := make([]entry, 0, len(edges))
+	for _, edge := range edges {
+		entries = append(entries, entry{
+			i:     idx.positions[edge.Source().Label()],
+			j:     idx.positions[edge.Destination().Label()],
+			value: edgeValue(g, edge),
+		})
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		if entries[a].i != entries[b].i {
+			return entries[a].i < entries[b].i
+		}
+
+		return entries[a].j < entries[b].j
+	})
+
+	coo := &COO{
+		Rows:   idx.Len(),
+		Cols:   idx.Len(),
+		RowIdx: make([]int, 0, len(entries)),
+		ColIdx: make([]int, 0, len(entries)),
+		Values: make([]float64, 0, len(entries)),
+	}
+	for _, e := range entries {
+		coo.Append(e.i, e.j, e.value)
+	}
+
+	return coo, nil
+}
+
+// AdjacencyCSR returns the adjacency matrix of the graph in the compressed
+// sparse row format. See Adjacency for the meaning of the elements.
+func AdjacencyCSR[T comparable](g gograph.Graph[T], idx *Index[T]) (*CSR, error) {
+	coo, err := AdjacencyCOO(g, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return coo.ToCSR()
+}
+
+// Incidence returns the incidence matrix of the graph along with the edges
+// in column order. Rows are vertices in index order, and each column is
+// one edge of the graph.
+//
+// In a directed graph, the element of the source vertex is -1 and the
+// element of the destination vertex is +1; a self-loop column is all zeros.
+// In an undirected graph, each edge appears once, and both of its endpoints
+// are 1; a self-loop has 2 in its single endpoint row.
+//
+// Columns are sorted by the positions of the source and destination
+// vertices, so the order is stable for the same index.
+func Incidence[T comparable](g gograph.Graph[T], idx *Index[T]) (*Dense, []*gograph.Edge[T], error) {
+	if err := idx.covers(g); err != nil {
+		return nil, nil, err
+	}
+
+	edges := make([]*gograph.Edge[T], 0)
+	for _, edge := range g.AllEdges() {
+		i := idx.positions[edge.Source().Label()]
+		j := idx.positions[edge.Destination().Label()]
+
+		// an undirected edge is stored in both directions, keep one of them.
+		if !g.IsDirected() && i > j {
+			continue
+		}
+
+		edges = append(edges, edge)
+	}
+
+	sort.Slice(edges, func(a, b int) bool {
+		ia, ja := idx.positions[edges[a].Source().Label()], idx.positions[edges[a].Destination().Label()]
+		ib, jb := idx.positions[edges[b].Source().Label()], idx.positions[edges[b].Destination().Label()]
+		if ia != ib {
+			return ia < ib
+		}
+
+		return ja < jb
+	})
+
+	m := NewDense(idx.Len(), len(edges))
+	for k, edge := range edges {
+		i := idx.positions[edge.Source().Label()]
+		j := idx.positions[edge.Destination().Label()]
+
+		if g.IsDirected() {
+			m.Set(i, k, m.At(i, k)-1)
+			m.Set(j, k, m.At(j, k)+1)
+			continue
+		}
+
+		m.Set(i, k, m.At(i, k)+1)
+		m.Set(j, k, m.At(j, k)+1)
+	}
+
+	return m, edges, nil
+}
+
+// Laplacian returns the Laplacian matrix L = D - A of the graph, where A
+// is the adjacency matrix (see Adjacency) and D is the diagonal matrix of
+// the weighted degrees. For directed graphs, D holds the weighted
+// out-degrees. Self-loops are ignored.
+func Laplacian[T comparable](g gograph.Graph[T], idx *Index[T]) (*Dense, error) {
+	if err := idx.covers(g); err != nil {
+		return nil, err
+	}
+
+	m := NewDense(idx.Len(), idx.Len())
+	for _, edge := range g.AllEdges() {
+		i := idx.positions[edge.Source().Label()]
+		j := idx.positions[edge.Destination().Label()]
+		if i == j {
+			continue
+		}
+
+		value := edgeValue(g, edge)
+		m.Set(i, j, m.At(i, j)-value)
+		m.Set(i, i, m.At(i, i)+value)
+	}
+
+	return m, nil
+}
+
+// edgeValue returns the matrix value of the edge, which is the edge
+// weight in weighted graphs, and 1 otherwise.
+func edgeValue[T comparable](g gograph.Graph[T], edge *gograph.Edge[T]) float64 {
+	if g.IsWeighted() {
+		return edge.Weight()
+	}
+
+	return 1
+}
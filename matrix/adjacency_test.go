@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func initAdjacencyTestGraph(options ...gograph.GraphOptionFunc) gograph.Graph[string] {
+	g := gograph.New[string](options...)
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vA, vC, gograph.WithEdgeWeight(4))
+	return g
+}
+
+func TestAdjacency(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Directed(), gograph.Weighted())
+	idx := NewIndex(g)
+
+	m, err := Adjacency(g, idx)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := [][]float64{
+		{0, 2, 4, 0},
+		{0, 0, 3, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, m.Rows())
+	}
+
+	// unweighted and undirected graph is symmetric and uses 1 for edges.
+	g = initAdjacencyTestGraph()
+	m, err = Adjacency(g, NewIndex(g))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected = [][]float64{
+		{0, 1, 1, 0},
+		{1, 0, 1, 0},
+		{1, 1, 0, 0},
+		{0, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, m.Rows())
+	}
+
+	idx, _ = NewIndexFromLabels("A", "B")
+	if _, err = Adjacency(g, idx); !errors.Is(err, ErrLabelNotIndexed) {
+		t.Errorf("Expected error %s, but got %v", ErrLabelNotIndexed, err)
+	}
+}
+
+func TestAdjacencyCSR(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Directed(), gograph.Weighted())
+	idx := NewIndex(g)
+
+	csr, err := AdjacencyCSR(g, idx)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(csr.RowPtr, []int{0, 2, 3, 3, 3}) {
+		t.Errorf("Unexpected row pointers %v", csr.RowPtr)
+	}
+
+	if csr.At(0, 2) != 4 {
+		t.Errorf("Expected element (0, 2) to be 4, but got %f", csr.At(0, 2))
+	}
+
+	coo, err := AdjacencyCOO(g, idx)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(coo.RowIdx, []int{0, 0, 1}) || !reflect.DeepEqual(coo.ColIdx, []int{1, 2, 2}) {
+		t.Errorf("Unexpected coordinates %v %v", coo.RowIdx, coo.ColIdx)
+	}
+}
+
+func TestIncidence(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Directed())
+	m, edges, err := Incidence(g, NewIndex(g))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(edges) != 3 {
+		t.Fatalf("Expected 3 edges, but got %d", len(edges))
+	}
+
+	// columns: A->B, A->C, B->C
+	expected := [][]float64{
+		{-1, -1, 0},
+		{1, 0, -1},
+		{0, 1, 1},
+		{0, 0, 0},
+	}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, m.Rows())
+	}
+
+	g = initAdjacencyTestGraph()
+	m, edges, err = Incidence(g, NewIndex(g))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(edges) != 3 {
+		t.Fatalf("Expected 3 edges, but got %d", len(edges))
+	}
+
+	expected = [][]float64{
+		{1, 1, 0},
+		{1, 0, 1},
+		{0, 1, 1},
+		{0, 0, 0},
+	}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, m.Rows())
+	}
+}
+
+func TestLaplacian(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Weighted())
+	m, err := Laplacian(g, NewIndex(g))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := [][]float64{
+		{6, -2, -4, 0},
+		{-2, 5, -3, 0},
+		{-4, -3, 7, 0},
+		{0, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, m.Rows())
+	}
+
+	// every row of a Laplacian sums to zero.
+	rows, cols := m.Dims()
+	for i := 0; i < rows; i++ {
+		var sum float64
+		for j := 0; j < cols; j++ {
+			sum += m.At(i, j)
+		}
+
+		if sum != 0 {
+			t.Errorf("Expected row %d to sum to zero, but got %f", i, sum)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// FromDense builds a graph from an adjacency matrix. The i-th label is
+// assigned to the vertex of the i-th row and column, and every non-zero
+// element becomes an edge whose weight is the element value.
+//
+// The graph is created with the specified options. If the resulting graph
+// is undirected, only one edge is added for each pair of mirrored elements
+// and their values must be equal. A non-zero element whose mirror is zero
+// is treated as a single undirected edge, so triangular matrices work too.
+//
+// It returns ErrNotSquare if the matrix is not square, ErrDimensionMismatch
+// if the number of labels doesn't match the matrix size, ErrDuplicateLabel
+// if the labels are not unique, and ErrNotSymmetric if an undirected graph
+// is requested and two mirrored elements are different.
+func FromDense[T comparable](m *Dense, labels []T, options ...gograph.GraphOptionFunc) (gograph.Graph[T], error) {
+	return FromCOO(m.ToCOO(), labels, options...)
+}
+
+// FromCOO builds a graph from an adjacency matrix in the coordinate
+// format. Unlike FromDense, every stored triple becomes an edge, even if
+// its value is zero. Duplicated coordinates are summed.
+//
+// See FromDense for the description of the options and errors.
+func FromCOO[T comparable](m *COO, labels []T, options ...gograph.GraphOptionFunc) (gograph.Graph[T], error) {
+	if m.Rows != m.Cols {
+		return nil, ErrNotSquare
+	}
+
+	if m.Rows != len(labels) {
+		return nil, ErrDimensionMismatch
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	idx, err := NewIndexFromLabels(labels...)
+	if err != nil {
+		return nil, err
+	}
+
+	g := gograph.New[T](options...)
+
+	vertices := make([]*gograph.Vertex[T], idx.Len())
+	for i := range vertices {
+		vertices[i] = g.AddVertexByLabel(idx.Label(i))
+	}
+
+	type coordinate struct{ i, j int }
+
+	values := make(map[coordinate]float64, m.NNZ())
+	order := make([]coordinate, 0, m.NNZ())
+	for k := range m.Values {
+		c := coordinate{i: m.RowIdx[k], j: m.ColIdx[k]}
+		if _, ok := values[c]; !ok {
+			order = append(order, c)
+		}
+
+		values[c] += m.Values[k]
+	}
+
+	for _, c := range order {
+		value := values[c]
+
+		if !g.IsDirected() {
+			mirror, ok := values[coordinate{i: c.j, j: c.i}]
+			if ok && mirror != value {
+				return nil, ErrNotSymmetric
+			}
+
+			if ok && c.i > c.j {
+				// the mirrored element has been added or will be added.
+				continue
+			}
+		}
+
+		_, err = g.AddEdge(vertices[c.i], vertices[c.j], gograph.WithEdgeWeight(value))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,98 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestFromDense(t *testing.T) {
+	m, _ := NewDenseFromRows([][]float64{
+		{0, 2, 0},
+		{0, 0, 5},
+		{1, 0, 0},
+	})
+
+	g, err := FromDense(m, []string{"A", "B", "C"}, gograph.Directed(), gograph.Weighted())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if g.Order() != 3 || g.Size() != 3 {
+		t.Errorf("Expected 3 vertices and 3 edges, but got %d and %d", g.Order(), g.Size())
+	}
+
+	edge := g.GetEdge(g.GetVertexByID("B"), g.GetVertexByID("C"))
+	if edge == nil || edge.Weight() != 5 {
+		t.Errorf("Expected edge B->C with weight 5, but got %+v", edge)
+	}
+
+	if _, err = FromDense(m, []string{"A", "B"}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected error %s, but got %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err = FromDense(m, []string{"A", "B", "A"}); !errors.Is(err, ErrDuplicateLabel) {
+		t.Errorf("Expected error %s, but got %v", ErrDuplicateLabel, err)
+	}
+
+	if _, err = FromDense(NewDense(2, 3), []string{"A", "B"}); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("Expected error %s, but got %v", ErrNotSquare, err)
+	}
+
+	// the cycle A->B->C->A cannot be added to an acyclic graph.
+	if _, err = FromDense(m, []string{"A", "B", "C"}, gograph.Acyclic()); !errors.Is(err, gograph.ErrDAGCycle) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrDAGCycle, err)
+	}
+}
+
+func TestFromDense_Undirected(t *testing.T) {
+	m, _ := NewDenseFromRows([][]float64{
+		{0, 1, 1},
+		{1, 0, 0},
+		{1, 0, 0},
+	})
+
+	g, err := FromDense(m, []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(g.GetAllEdges(g.GetVertexByID(1), g.GetVertexByID(3))) != 2 {
+		t.Errorf("Expected undirected edge between 1 and 3")
+	}
+
+	m.Set(1, 0, 3)
+	if _, err = FromDense(m, []int{1, 2, 3}); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("Expected error %s, but got %v", ErrNotSymmetric, err)
+	}
+}
+
+func TestFromCOO_RoundTrip(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Directed(), gograph.Weighted())
+	idx := NewIndex(g)
+
+	coo, err := AdjacencyCOO(g, idx)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	clone, err := FromCOO(coo, idx.Labels(), gograph.Directed(), gograph.Weighted())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if clone.Order() != g.Order() || clone.Size() != g.Size() {
+		t.Errorf("Expected %d vertices and %d edges, but got %d and %d", g.Order(), g.Size(), clone.Order(), clone.Size())
+	}
+
+	for _, edge := range g.AllEdges() {
+		e := clone.GetEdge(
+			clone.GetVertexByID(edge.Source().Label()),
+			clone.GetVertexByID(edge.Destination().Label()),
+		)
+		if e == nil || e.Weight() != edge.Weight() {
+			t.Errorf("Expected edge %s->%s with weight %f", edge.Source().Label(), edge.Destination().Label(), edge.Weight())
+		}
+	}
+}
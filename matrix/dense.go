@@ -0,0 +1,87 @@
+package matrix
+
+// Dense is a row-major dense matrix of float64 values.
+type Dense struct {
+	rows int
+	cols int
+	data []float64
+}
+
+// NewDense creates a rows x cols matrix filled with zeros.
+func NewDense(rows, cols int) *Dense {
+	return &Dense{
+		rows: rows,
+		cols: cols,
+		data: make([]float64, rows*cols),
+	}
+}
+
+// NewDenseFromRows creates a dense matrix from a slice of rows. All the
+// rows must have the same length, otherwise returns ErrDimensionMismatch.
+func NewDenseFromRows(rows [][]float64) (*Dense, error) {
+	if len(rows) == 0 {
+		return NewDense(0, 0), nil
+	}
+
+	m := NewDense(len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.cols {
+			return nil, ErrDimensionMismatch
+		}
+
+		copy(m.data[i*m.cols:(i+1)*m.cols], row)
+	}
+
+	return m, nil
+}
+
+// Dims returns the number of rows and columns of the matrix.
+func (m *Dense) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+// At returns the value of the element at row i and column j. It panics
+// if the indices are out of range.
+func (m *Dense) At(i, j int) float64 {
+	return m.data[m.offset(i, j)]
+}
+
+// Set sets the value of the element at row i and column j. It panics
+// if the indices are out of range.
+func (m *Dense) Set(i, j int, value float64) {
+	m.data[m.offset(i, j)] = value
+}
+
+// Rows returns a copy of the matrix as a slice of rows.
+func (m *Dense) Rows() [][]float64 {
+	out := make([][]float64, m.rows)
+	for i := range out {
+		out[i] = make([]float64, m.cols)
+		copy(out[i], m.data[i*m.cols:(i+1)*m.cols])
+	}
+
+	return out
+}
+
+// ToCOO converts the dense matrix to the coordinate format. Zero
+// elements are skipped.
+func (m *Dense) ToCOO() *COO {
+	coo := &COO{Rows: m.rows, Cols: m.cols}
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			if value := m.At(i, j); value != 0 {
+				coo.Append(i, j, value)
+			}
+		}
+	}
+
+	return coo
+}
+
+func (m *Dense) offset(i, j int) int {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("matrix: index out of range")
+	}
+
+	return i*m.cols + j
+}
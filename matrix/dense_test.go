@@ -0,0 +1,64 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDense(t *testing.T) {
+	m := NewDense(2, 3)
+	m.Set(0, 1, 4)
+	m.Set(1, 2, -1)
+
+	rows, cols := m.Dims()
+	if rows != 2 || cols != 3 {
+		t.Errorf("Expected dims 2x3, but got %dx%d", rows, cols)
+	}
+
+	if m.At(0, 1) != 4 || m.At(1, 2) != -1 || m.At(0, 0) != 0 {
+		t.Errorf("Unexpected matrix values %v", m.Rows())
+	}
+
+	expected := [][]float64{{0, 4, 0}, {0, 0, -1}}
+	if !reflect.DeepEqual(m.Rows(), expected) {
+		t.Errorf("Expected rows %v, but got %v", expected, m.Rows())
+	}
+
+	coo := m.ToCOO()
+	if coo.NNZ() != 2 {
+		t.Errorf("Expected 2 non-zero elements, but got %d", coo.NNZ())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected At to panic for out of range index")
+		}
+	}()
+	m.At(2, 0)
+}
+
+func TestNewDenseFromRows(t *testing.T) {
+	m, err := NewDenseFromRows([][]float64{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if m.At(1, 0) != 3 {
+		t.Errorf("Expected element (1, 0) to be 3, but got %f", m.At(1, 0))
+	}
+
+	_, err = NewDenseFromRows([][]float64{{1, 2}, {3}})
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected error %s, but got %v", ErrDimensionMismatch, err)
+	}
+
+	m, err = NewDenseFromRows(nil)
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if rows, cols := m.Dims(); rows != 0 || cols != 0 {
+		t.Errorf("Expected empty matrix, but got %dx%d", rows, cols)
+	}
+}
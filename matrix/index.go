@@ -0,0 +1,104 @@
+package matrix
+
+import (
+	"errors"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+var (
+	ErrDuplicateLabel    = errors.New("duplicate label")
+	ErrLabelNotIndexed   = errors.New("label is not indexed")
+	ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+	ErrNotSquare         = errors.New("matrix is not square")
+	ErrNotSymmetric      = errors.New("matrix is not symmetric")
+)
+
+// Index is a stable bijection between vertex labels and matrix row/column
+// positions. All the conversions in this package use an Index to decide
+// which row and column belong to which vertex, so the same Index must be
+// used to read the results back.
+type Index[T comparable] struct {
+	labels    []T       // position -> label
+	positions map[T]int // label -> position
+}
+
+// NewIndex creates an Index that contains all the vertices of the specified
+// graph. Vertices are ordered by their labels (see util.CompareLabels), so
+// the mapping doesn't change between runs for the same set of vertices.
+func NewIndex[T comparable](g gograph.Graph[T]) *Index[T] {
+	vertices := util.SortedVertices(g)
+
+	idx := &Index[T]{
+		labels:    make([]T, len(vertices)),
+		positions: make(map[T]int, len(vertices)),
+	}
+
+	for i, v := range vertices {
+		idx.labels[i] = v.Label()
+		idx.positions[v.Label()] = i
+	}
+
+	return idx
+}
+
+// NewIndexFromLabels creates an Index that keeps the exact order of the
+// input labels, which is useful when the matrix is produced by another
+// tool that has its own vertex order.
+//
+// It returns ErrDuplicateLabel if a label appears more than once.
+func NewIndexFromLabels[T comparable](labels ...T) (*Index[T], error) {
+	idx := &Index[T]{
+		labels:    make([]T, len(labels)),
+		positions: make(map[T]int, len(labels)),
+	}
+
+	for i, label := range labels {
+		if _, ok := idx.positions[label]; ok {
+			return nil, ErrDuplicateLabel
+		}
+
+		idx.labels[i] = label
+		idx.positions[label] = i
+	}
+
+	return idx, nil
+}
+
+// Position returns the row/column position of the specified label. The
+// second return value is false if the label is not indexed.
+func (idx *Index[T]) Position(label T) (int, bool) {
+	i, ok := idx.positions[label]
+	return i, ok
+}
+
+// Label returns the label at the specified position. It panics if the
+// position is out of range.
+func (idx *Index[T]) Label(position int) T {
+	return idx.labels[position]
+}
+
+// Labels returns a copy of indexed labels in position order.
+func (idx *Index[T]) Labels() []T {
+	out := make([]T, len(idx.labels))
+	copy(out, idx.labels)
+	return out
+}
+
+// Len returns the number of indexed labels.
+func (idx *Index[T]) Len() int {
+	return len(idx.labels)
+}
+
+// covers returns ErrLabelNotIndexed if any vertex of the graph is not
+// part of the index.
+func (idx *Index[T]) covers(g gograph.Graph[T]) error {
+	for _, v := range g.GetAllVertices() {
+		if _, ok := idx.positions[v.Label()]; !ok {
+			return ErrLabelNotIndexed
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestNewIndex(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(10), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(2), gograph.NewVertex(7))
+
+	idx := NewIndex(g)
+	if idx.Len() != 3 {
+		t.Errorf("Expected index length 3, but got %d", idx.Len())
+	}
+
+	expected := []int{2, 7, 10}
+	if !reflect.DeepEqual(idx.Labels(), expected) {
+		t.Errorf("Expected labels %v, but got %v", expected, idx.Labels())
+	}
+
+	for i, label := range expected {
+		pos, ok := idx.Position(label)
+		if !ok || pos != i {
+			t.Errorf("Expected position of %d to be %d, but got %d", label, i, pos)
+		}
+
+		if idx.Label(i) != label {
+			t.Errorf("Expected label at %d to be %d, but got %d", i, label, idx.Label(i))
+		}
+	}
+
+	if _, ok := idx.Position(100); ok {
+		t.Error("Expected label 100 not to be indexed")
+	}
+}
+
+func TestNewIndexFromLabels(t *testing.T) {
+	idx, err := NewIndexFromLabels("c", "a", "b")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if pos, _ := idx.Position("c"); pos != 0 {
+		t.Errorf("Expected position of c to be 0, but got %d", pos)
+	}
+
+	_, err = NewIndexFromLabels("a", "b", "a")
+	if !errors.Is(err, ErrDuplicateLabel) {
+		t.Errorf("Expected error %s, but got %v", ErrDuplicateLabel, err)
+	}
+}
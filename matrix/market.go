@@ -0,0 +1,260 @@
+package matrix
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+)
+
+var ErrInvalidMatrixMarket = errors.New("invalid matrix market data")
+
+const matrixMarketBanner = "%%MatrixMarket"
+
+// ReadMatrixMarket reads a matrix in the Matrix Market exchange format
+// (.mtx) and returns it in the coordinate format.
+//
+// Supported headers are "matrix coordinate" and "matrix array" with the
+// real, integer or pattern field, and the general, symmetric or
+// skew-symmetric symmetry. Elements of a pattern matrix get the value 1,
+// and the mirrored elements of symmetric matrices are expanded, so the
+// result always contains the full matrix.
+//
+// Malformed or truncated input returns an error that wraps
+// ErrInvalidMatrixMarket.
+func ReadMatrixMarket(r io.Reader) (*COO, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	if !scanner.Scan() {
+		return nil, invalidMatrixMarket("missing header")
+	}
+
+	header := strings.Fields(strings.ToLower(scanner.Text()))
+	if len(header) != 5 || header[0] != strings.ToLower(matrixMarketBanner) || header[1] != "matrix" {
+		return nil, invalidMatrixMarket("unsupported header %q", scanner.Text())
+	}
+
+	format, field, symmetry := header[2], header[3], header[4]
+	if format != "coordinate" && format != "array" {
+		return nil, invalidMatrixMarket("unsupported format %q", format)
+	}
+
+	if field != "real" && field != "integer" && field != "pattern" {
+		return nil, invalidMatrixMarket("unsupported field %q", field)
+	}
+
+	if field == "pattern" && format == "array" {
+		return nil, invalidMatrixMarket("pattern field requires coordinate format")
+	}
+
+	if symmetry != "general" && symmetry != "symmetric" && symmetry != "skew-symmetric" {
+		return nil, invalidMatrixMarket("unsupported symmetry %q", symmetry)
+	}
+
+	lines := &dataLines{scanner: scanner}
+
+	size, err := lines.next()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &COO{}
+	if format == "coordinate" {
+		var nnz int
+		if err = parseInts(size, &m.Rows, &m.Cols, &nnz); err != nil {
+			return nil, err
+		}
+
+		for k := 0; k < nnz; k++ {
+			var fields []string
+			fields, err = lines.next()
+			if err != nil {
+				return nil, err
+			}
+
+			var i, j int
+			value := 1.0
+			if field == "pattern" {
+				err = parseInts(fields, &i, &j)
+			} else {
+				err = parseEntry(fields, &i, &j, &value)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if i < 1 || i > m.Rows || j < 1 || j > m.Cols {
+				return nil, invalidMatrixMarket("entry (%d, %d) out of range", i, j)
+			}
+
+			appendSymmetric(m, i-1, j-1, value, symmetry)
+		}
+
+		return m, nil
+	}
+
+	if err = parseInts(size, &m.Rows, &m.Cols); err != nil {
+		return nil, err
+	}
+
+	// array format stores the elements in column-major order, and only the
+	// lower triangle of the symmetric matrices.
+	for j := 0; j < m.Cols; j++ {
+		first := 0
+		if symmetry == "symmetric" {
+			first = j
+		} else if symmetry == "skew-symmetric" {
+			first = j + 1
+		}
+
+		for i := first; i < m.Rows; i++ {
+			var fields []string
+			fields, err = lines.next()
+			if err != nil {
+				return nil, err
+			}
+
+			if len(fields) != 1 {
+				return nil, invalidMatrixMarket("expected a single value, got %q", strings.Join(fields, " "))
+			}
+
+			var value float64
+			value, err = strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return nil, invalidMatrixMarket("invalid value %q", fields[0])
+			}
+
+			if value != 0 {
+				appendSymmetric(m, i, j, value, symmetry)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// WriteMatrixMarket writes the matrix in the Matrix Market exchange
+// format, using the "matrix coordinate real general" header.
+func WriteMatrixMarket(w io.Writer, m *COO) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+
+	_, _ = fmt.Fprintf(bw, "%s matrix coordinate real general\n", matrixMarketBanner)
+	_, _ = fmt.Fprintf(bw, "%d %d %d\n", m.Rows, m.Cols, m.NNZ())
+	for k := range m.Values {
+		_, _ = fmt.Fprintf(
+			bw,
+			"%d %d %s\n",
+			m.RowIdx[k]+1,
+			m.ColIdx[k]+1,
+			strconv.FormatFloat(m.Values[k], 'g', -1, 64),
+		)
+	}
+
+	return bw.Flush()
+}
+
+// ReadGraph reads an adjacency matrix in the Matrix Market exchange format
+// and builds a graph from it using FromCOO.
+func ReadGraph[T comparable](r io.Reader, labels []T, options ...gograph.GraphOptionFunc) (gograph.Graph[T], error) {
+	m, err := ReadMatrixMarket(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return FromCOO(m, labels, options...)
+}
+
+// WriteGraph writes the adjacency matrix of the graph in the Matrix Market
+// exchange format. The rows and columns follow the order of the index.
+func WriteGraph[T comparable](w io.Writer, g gograph.Graph[T], idx *Index[T]) error {
+	m, err := AdjacencyCOO(g, idx)
+	if err != nil {
+		return err
+	}
+
+	return WriteMatrixMarket(w, m)
+}
+
+// dataLines returns the fields of the non-empty, non-comment lines.
+type dataLines struct {
+	scanner *bufio.Scanner
+}
+
+func (d *dataLines) next() ([]string, error) {
+	for d.scanner.Scan() {
+		line := strings.TrimSpace(d.scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		return strings.Fields(line), nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, invalidMatrixMarket("unexpected end of input")
+}
+
+func appendSymmetric(m *COO, i, j int, value float64, symmetry string) {
+	m.Append(i, j, value)
+	if i == j {
+		return
+	}
+
+	switch symmetry {
+	case "symmetric":
+		m.Append(j, i, value)
+	case "skew-symmetric":
+		m.Append(j, i, -value)
+	}
+}
+
+func parseInts(fields []string, out ...*int) error {
+	if len(fields) != len(out) {
+		return invalidMatrixMarket("expected %d integers, got %q", len(out), strings.Join(fields, " "))
+	}
+
+	for k, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return invalidMatrixMarket("invalid integer %q", f)
+		}
+
+		*out[k] = n
+	}
+
+	return nil
+}
+
+func parseEntry(fields []string, i, j *int, value *float64) error {
+	if len(fields) != 3 {
+		return invalidMatrixMarket("expected 3 fields, got %q", strings.Join(fields, " "))
+	}
+
+	if err := parseInts(fields[:2], i, j); err != nil {
+		return err
+	}
+
+	v, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return invalidMatrixMarket("invalid value %q", fields[2])
+	}
+
+	*value = v
+	return nil
+}
+
+func invalidMatrixMarket(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMatrixMarket, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,126 @@
+package matrix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestReadMatrixMarket_Coordinate(t *testing.T) {
+	input := `%%MatrixMarket matrix coordinate real symmetric
+% a comment
+3 3 3
+1 1 4.5
+2 1 1
+3 2 -2
+`
+	m, err := ReadMatrixMarket(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	d, _ := m.ToDense()
+	expected := [][]float64{
+		{4.5, 1, 0},
+		{1, 0, -2},
+		{0, -2, 0},
+	}
+	if !reflect.DeepEqual(d.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, d.Rows())
+	}
+}
+
+func TestReadMatrixMarket_PatternAndArray(t *testing.T) {
+	input := `%%MatrixMarket matrix coordinate pattern general
+2 2 1
+1 2
+`
+	m, err := ReadMatrixMarket(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if m.NNZ() != 1 || m.Values[0] != 1 {
+		t.Errorf("Expected a single element with value 1, but got %v", m.Values)
+	}
+
+	input = `%%MatrixMarket matrix array real general
+2 2
+1
+0
+3
+4
+`
+	m, err = ReadMatrixMarket(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	d, _ := m.ToDense()
+	expected := [][]float64{{1, 3}, {0, 4}}
+	if !reflect.DeepEqual(d.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, d.Rows())
+	}
+}
+
+func TestReadMatrixMarket_Invalid(t *testing.T) {
+	inputs := []string{
+		"",
+		"%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 x\n",
+		"%%MatrixMarket matrix array real general\n1 2\n1\n",
+		"not a header\n",
+	}
+
+	for _, input := range inputs {
+		if _, err := ReadMatrixMarket(strings.NewReader(input)); !errors.Is(err, ErrInvalidMatrixMarket) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidMatrixMarket, input, err)
+		}
+	}
+}
+
+func TestWriteMatrixMarket(t *testing.T) {
+	coo := &COO{Rows: 2, Cols: 3}
+	coo.Append(0, 2, 1.5)
+	coo.Append(1, 0, -2)
+
+	var buf bytes.Buffer
+	if err := WriteMatrixMarket(&buf, coo); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := "%%MatrixMarket matrix coordinate real general\n2 3 2\n1 3 1.5\n2 1 -2\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, but got %q", expected, buf.String())
+	}
+}
+
+func TestWriteReadGraph(t *testing.T) {
+	g := initAdjacencyTestGraph(gograph.Directed(), gograph.Weighted())
+	idx := NewIndex(g)
+
+	var buf bytes.Buffer
+	if err := WriteGraph(&buf, g, idx); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	clone, err := ReadGraph(&buf, idx.Labels(), gograph.Directed(), gograph.Weighted())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if clone.Order() != g.Order() || clone.Size() != g.Size() {
+		t.Errorf("Expected %d vertices and %d edges, but got %d and %d", g.Order(), g.Size(), clone.Order(), clone.Size())
+	}
+
+	edge := clone.GetEdge(clone.GetVertexByID("A"), clone.GetVertexByID("C"))
+	if edge == nil || edge.Weight() != 4 {
+		t.Errorf("Expected edge A->C with weight 4, but got %+v", edge)
+	}
+}
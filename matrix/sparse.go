@@ -0,0 +1,165 @@
+package matrix
+
+import "sort"
+
+// COO is a sparse matrix in the coordinate (triplet) format. Each
+// non-zero element is stored as a (row, column, value) triple at the
+// same position of RowIdx, ColIdx and Values.
+type COO struct {
+	Rows   int
+	Cols   int
+	RowIdx []int
+	ColIdx []int
+	Values []float64
+}
+
+// Append adds a new (i, j, value) triple to the matrix. It doesn't check
+// for duplicates; duplicated coordinates are summed when the matrix is
+// converted to another format.
+func (m *COO) Append(i, j int, value float64) {
+	m.RowIdx = append(m.RowIdx, i)
+	m.ColIdx = append(m.ColIdx, j)
+	m.Values = append(m.Values, value)
+}
+
+// NNZ returns the number of stored elements.
+func (m *COO) NNZ() int {
+	return len(m.Values)
+}
+
+// ToDense converts the coordinate matrix to a dense matrix. Returns
+// ErrDimensionMismatch if any coordinate is out of range.
+func (m *COO) ToDense() (*Dense, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	d := NewDense(m.Rows, m.Cols)
+	for k := range m.Values {
+		d.Set(m.RowIdx[k], m.ColIdx[k], d.At(m.RowIdx[k], m.ColIdx[k])+m.Values[k])
+	}
+
+	return d, nil
+}
+
+// ToCSR converts the coordinate matrix to the compressed sparse row format.
+// Column indices of each row are sorted, and duplicated coordinates are
+// summed. Returns ErrDimensionMismatch if any coordinate is out of range.
+func (m *COO) ToCSR() (*CSR, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(m.Values))
+	for k := range order {
+		order[k] = k
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		if m.RowIdx[order[a]] != m.RowIdx[order[b]] {
+			return m.RowIdx[order[a]] < m.RowIdx[order[b]]
+		}
+
+		return m.ColIdx[order[a]] < m.ColIdx[order[b]]
+	})
+
+	csr := &CSR{
+		Rows:   m.Rows,
+		Cols:   m.Cols,
+		RowPtr: make([]int, m.Rows+1),
+	}
+
+	lastRow, lastCol := -1, -1
+	for _, k := range order {
+		i, j := m.RowIdx[k], m.ColIdx[k]
+		if i == lastRow && j == lastCol {
+			csr.Values[len(csr.Values)-1] += m.Values[k]
+			continue
+		}
+
+		csr.ColIdx = append(csr.ColIdx, j)
+		csr.Values = append(csr.Values, m.Values[k])
+		csr.RowPtr[i+1]++
+		lastRow, lastCol = i, j
+	}
+
+	for i := 0; i < m.Rows; i++ {
+		csr.RowPtr[i+1] += csr.RowPtr[i]
+	}
+
+	return csr, nil
+}
+
+func (m *COO) validate() error {
+	if len(m.RowIdx) != len(m.Values) || len(m.ColIdx) != len(m.Values) {
+		return ErrDimensionMismatch
+	}
+
+	for k := range m.Values {
+		if m.RowIdx[k] < 0 || m.RowIdx[k] >= m.Rows || m.ColIdx[k] < 0 || m.ColIdx[k] >= m.Cols {
+			return ErrDimensionMismatch
+		}
+	}
+
+	return nil
+}
+
+// CSR is a sparse matrix in the compressed sparse row format. The column
+// indices and values of row i are stored in ColIdx[RowPtr[i]:RowPtr[i+1]]
+// and Values[RowPtr[i]:RowPtr[i+1]].
+type CSR struct {
+	Rows   int
+	Cols   int
+	RowPtr []int
+	ColIdx []int
+	Values []float64
+}
+
+// NNZ returns the number of stored elements.
+func (m *CSR) NNZ() int {
+	return len(m.Values)
+}
+
+// At returns the value of the element at row i and column j. It panics
+// if the row is out of range.
+func (m *CSR) At(i, j int) float64 {
+	cols := m.ColIdx[m.RowPtr[i]:m.RowPtr[i+1]]
+	k := sort.SearchInts(cols, j)
+	if k < len(cols) && cols[k] == j {
+		return m.Values[m.RowPtr[i]+k]
+	}
+
+	return 0
+}
+
+// Row returns the column indices and values of the non-zero elements of
+// row i. The returned slices share memory with the matrix.
+func (m *CSR) Row(i int) ([]int, []float64) {
+	return m.ColIdx[m.RowPtr[i]:m.RowPtr[i+1]], m.Values[m.RowPtr[i]:m.RowPtr[i+1]]
+}
+
+// ToCOO converts the compressed sparse row matrix to the coordinate format.
+func (m *CSR) ToCOO() *COO {
+	coo := &COO{Rows: m.Rows, Cols: m.Cols}
+	for i := 0; i < m.Rows; i++ {
+		cols, values := m.Row(i)
+		for k := range cols {
+			coo.Append(i, cols[k], values[k])
+		}
+	}
+
+	return coo
+}
+
+// ToDense converts the compressed sparse row matrix to a dense matrix.
+func (m *CSR) ToDense() *Dense {
+	d := NewDense(m.Rows, m.Cols)
+	for i := 0; i < m.Rows; i++ {
+		cols, values := m.Row(i)
+		for k := range cols {
+			d.Set(i, cols[k], values[k])
+		}
+	}
+
+	return d
+}
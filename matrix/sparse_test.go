@@ -0,0 +1,60 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCOO_ToCSR(t *testing.T) {
+	coo := &COO{Rows: 3, Cols: 3}
+	coo.Append(2, 0, 5)
+	coo.Append(0, 2, 1)
+	coo.Append(0, 1, 2)
+	coo.Append(0, 1, 3) // duplicate, summed
+
+	csr, err := coo.ToCSR()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(csr.RowPtr, []int{0, 2, 2, 3}) {
+		t.Errorf("Unexpected row pointers %v", csr.RowPtr)
+	}
+
+	if !reflect.DeepEqual(csr.ColIdx, []int{1, 2, 0}) {
+		t.Errorf("Unexpected column indices %v", csr.ColIdx)
+	}
+
+	if csr.At(0, 1) != 5 || csr.At(2, 0) != 5 || csr.At(1, 1) != 0 {
+		t.Errorf("Unexpected values %v", csr.Values)
+	}
+
+	cols, values := csr.Row(0)
+	if !reflect.DeepEqual(cols, []int{1, 2}) || !reflect.DeepEqual(values, []float64{5, 1}) {
+		t.Errorf("Unexpected row 0: %v %v", cols, values)
+	}
+
+	if csr.ToCOO().NNZ() != 3 {
+		t.Errorf("Expected 3 non-zero elements, but got %d", csr.ToCOO().NNZ())
+	}
+
+	dense := csr.ToDense()
+	expected := [][]float64{{0, 5, 1}, {0, 0, 0}, {5, 0, 0}}
+	if !reflect.DeepEqual(dense.Rows(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, dense.Rows())
+	}
+}
+
+func TestCOO_Invalid(t *testing.T) {
+	coo := &COO{Rows: 2, Cols: 2}
+	coo.Append(2, 0, 1)
+
+	if _, err := coo.ToDense(); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected error %s, but got %v", ErrDimensionMismatch, err)
+	}
+
+	if _, err := coo.ToCSR(); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected error %s, but got %v", ErrDimensionMismatch, err)
+	}
+}
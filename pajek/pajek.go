@@ -0,0 +1,468 @@
+package pajek
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+var ErrInvalidFormat = errors.New("invalid Pajek data")
+
+// maxVertices is the largest number of vertices that Read accepts. The
+// vertices 1..n are added even if no line refers to them, so a larger
+// count in untrusted input would exhaust the memory.
+const maxVertices = 1 << 24
+
+// Attributes holds the attributes of a Pajek vertex or edge. The vertex
+// label is stored under the "label" key and the coordinates under the
+// "x", "y", and "z" keys as float64. The other parameters, such as the
+// color "ic Red", are stored as strings.
+type Attributes map[string]any
+
+// section is the kind of the lines after a star line.
+type section int
+
+const (
+	sectionNone section = iota
+	sectionVertices
+	sectionArcs
+	sectionEdges
+	sectionArcsList
+	sectionEdgesList
+	sectionMatrix
+)
+
+type arc struct {
+	source, target int
+	weight         float64
+	directed       bool
+	attrs          Attributes
+}
+
+// Read reads a Pajek network (.net) file. The vertices are labeled by
+// their numbers 1..n, and the vertex labels, coordinates, and the other
+// parameters are stored in the vertex metadata as Attributes.
+//
+// The lines of the *Arcs, *Arcslist, and *Matrix sections are directed
+// arcs, and the lines of the *Edges and *Edgeslist sections are undirected
+// edges. If the file contains any arc, the graph is directed and each
+// undirected edge is added in both directions. The third number of an arc
+// or edge line is its weight, and the graph is weighted if any line has a
+// weight. The edges without a weight, including the list entries, have
+// the Pajek default weight of 1. Repeated edges are added once, since
+// gograph doesn't support parallel edges.
+//
+// Malformed lines, references to unknown vertices, and vertex counts
+// above 2^24 return an error that wraps ErrInvalidFormat and contains the
+// line number.
+func Read(r io.Reader) (gograph.Graph[int], error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	var (
+		n        = -1
+		current  = sectionNone
+		row      int
+		attrs    = make(map[int]Attributes)
+		arcs     []arc
+		directed bool
+		weighted bool
+	)
+
+	for line := 1; scanner.Scan(); line++ {
+		fields, err := split(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", ErrInvalidFormat, line, err)
+		}
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "%") {
+			continue
+		}
+
+		lineErr := func(format string, args ...any) error {
+			return fmt.Errorf("%w: line %d: %s", ErrInvalidFormat, line, fmt.Sprintf(format, args...))
+		}
+
+		if strings.HasPrefix(fields[0], "*") {
+			current = sectionNone
+			switch strings.ToLower(fields[0]) {
+			case "*network":
+			case "*vertices":
+				if len(fields) < 2 {
+					return nil, lineErr("missing number of vertices")
+				}
+
+				n, err = strconv.Atoi(fields[1])
+				if err != nil || n < 0 {
+					return nil, lineErr("invalid number of vertices %q", fields[1])
+				}
+
+				if n > maxVertices {
+					return nil, lineErr("number of vertices %d exceeds %d", n, maxVertices)
+				}
+
+				current = sectionVertices
+			case "*arcs":
+				current = sectionArcs
+			case "*edges":
+				current = sectionEdges
+			case "*arcslist":
+				current = sectionArcsList
+			case "*edgeslist":
+				current = sectionEdgesList
+			case "*matrix":
+				current = sectionMatrix
+				row = 0
+			default:
+				return nil, lineErr("unsupported section %s", fields[0])
+			}
+
+			if current != sectionNone && current != sectionVertices && n < 0 {
+				return nil, lineErr("%s before *Vertices", fields[0])
+			}
+
+			continue
+		}
+
+		numbers := make([]int, 0, len(fields))
+		for _, field := range fields {
+			id, err := strconv.Atoi(field)
+			if err != nil {
+				break
+			}
+
+			numbers = append(numbers, id)
+		}
+
+		valid := func(ids ...int) error {
+			for _, id := range ids {
+				if id < 1 || id > n {
+					return lineErr("vertex %d is out of range 1..%d", id, n)
+				}
+			}
+
+			return nil
+		}
+
+		switch current {
+		case sectionNone:
+			return nil, lineErr("data outside of a section")
+		case sectionVertices:
+			if len(numbers) == 0 {
+				return nil, lineErr("invalid vertex %q", fields[0])
+			}
+
+			if err = valid(numbers[0]); err != nil {
+				return nil, err
+			}
+
+			attrs[numbers[0]] = vertexAttributes(fields[1:])
+		case sectionArcs, sectionEdges:
+			if len(numbers) < 2 {
+				return nil, lineErr("invalid edge %q", scanner.Text())
+			}
+
+			if err = valid(numbers[0], numbers[1]); err != nil {
+				return nil, err
+			}
+
+			e := arc{source: numbers[0], target: numbers[1], weight: 1, directed: current == sectionArcs}
+			rest := fields[2:]
+			if len(rest) > 0 {
+				if weight, err := strconv.ParseFloat(rest[0], 64); err == nil {
+					e.weight = weight
+					weighted = true
+					rest = rest[1:]
+				}
+			}
+
+			e.attrs = parameters(rest)
+			directed = directed || e.directed
+			arcs = append(arcs, e)
+		case sectionArcsList, sectionEdgesList:
+			if len(numbers) != len(fields) {
+				return nil, lineErr("invalid list %q", scanner.Text())
+			}
+
+			if err = valid(numbers...); err != nil {
+				return nil, err
+			}
+
+			for _, target := range numbers[1:] {
+				arcs = append(arcs, arc{source: numbers[0], target: target, weight: 1, directed: current == sectionArcsList})
+			}
+
+			directed = directed || current == sectionArcsList
+		case sectionMatrix:
+			row++
+			if row > n || len(fields) != n {
+				return nil, lineErr("expected %d x %d matrix", n, n)
+			}
+
+			for col, field := range fields {
+				weight, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return nil, lineErr("invalid matrix value %q", field)
+				}
+
+				if weight == 0 {
+					continue
+				}
+
+				weighted = weighted || weight != 1
+				arcs = append(arcs, arc{source: row, target: col + 1, weight: weight, directed: true})
+			}
+
+			directed = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, fmt.Errorf("%w: missing *Vertices", ErrInvalidFormat)
+	}
+
+	return build(n, attrs, arcs, directed, weighted)
+}
+
+func build(n int, attrs map[int]Attributes, arcs []arc, directed, weighted bool) (gograph.Graph[int], error) {
+	var options []gograph.GraphOptionFunc
+	if directed {
+		options = append(options, gograph.Directed())
+	}
+
+	if weighted {
+		options = append(options, gograph.Weighted())
+	}
+
+	g := gograph.New[int](options...)
+	for id := 1; id <= n; id++ {
+		if a, ok := attrs[id]; ok {
+			g.AddVertexByLabel(id, gograph.WithVertexMetadata(a))
+			continue
+		}
+
+		g.AddVertexByLabel(id)
+	}
+
+	add := func(source, target *gograph.Vertex[int], e arc) error {
+		if g.ContainsEdge(source, target) {
+			return nil
+		}
+
+		edgeOptions := []gograph.EdgeOptionFunc{gograph.WithEdgeWeight(e.weight)}
+		if len(e.attrs) > 0 {
+			edgeOptions = append(edgeOptions, gograph.WithEdgeMetadata(e.attrs))
+		}
+
+		_, err := g.AddEdge(source, target, edgeOptions...)
+		return err
+	}
+
+	for _, e := range arcs {
+		source, target := g.GetVertexByID(e.source), g.GetVertexByID(e.target)
+		if err := add(source, target, e); err != nil {
+			return nil, err
+		}
+
+		if directed && !e.directed {
+			if err := add(target, source, e); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// vertexAttributes parses the fields after the vertex number: the label,
+// the optional coordinates, and the parameters.
+func vertexAttributes(fields []string) Attributes {
+	attrs := make(Attributes)
+	if len(fields) == 0 {
+		return attrs
+	}
+
+	attrs["label"] = fields[0]
+	fields = fields[1:]
+
+	for _, key := range []string{"x", "y", "z"} {
+		if len(fields) == 0 {
+			break
+		}
+
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			break
+		}
+
+		attrs[key] = value
+		fields = fields[1:]
+	}
+
+	for key, value := range parameters(fields) {
+		attrs[key] = value
+	}
+
+	return attrs
+}
+
+// parameters parses the "name value" pairs, such as "ic Red bc Black".
+// A trailing name without a value is ignored.
+func parameters(fields []string) Attributes {
+	attrs := make(Attributes)
+	for i := 0; i+1 < len(fields); i += 2 {
+		attrs[fields[i]] = fields[i+1]
+	}
+
+	return attrs
+}
+
+// split splits the line into fields separated by spaces. Quoted fields
+// may contain spaces, and they are returned without the quotes.
+func split(line string) ([]string, error) {
+	var fields []string
+	for {
+		line = strings.TrimLeft(line, " \t\r")
+		if line == "" {
+			return fields, nil
+		}
+
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				return nil, errors.New("unterminated string")
+			}
+
+			fields = append(fields, line[1:end+1])
+			line = line[end+2:]
+			continue
+		}
+
+		end := strings.IndexAny(line, " \t\r")
+		if end < 0 {
+			end = len(line)
+		}
+
+		fields = append(fields, line[:end])
+		line = line[end:]
+	}
+}
+
+// Write writes the graph as a Pajek network (.net) file.
+//
+// The vertices are numbered 1..n in the label order. The vertex label is
+// taken from the "label" key of an Attributes metadata, or formatted from
+// the vertex label, and the "x", "y", and "z" coordinates are written if
+// present. Directed graphs are written as *Arcs and undirected graphs as
+// *Edges, where each edge is written once. The edge weights are written
+// if the graph is weighted. The other keys of the vertex and edge
+// Attributes metadata are written as parameters, such as "ic Red", so
+// Read restores them.
+func Write[T comparable](w io.Writer, g gograph.Graph[T]) error {
+	vertices := util.SortedVertices(g)
+	ids := make(map[T]int, len(vertices))
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(bw, "*Vertices %d\n", len(vertices))
+	for i, v := range vertices {
+		ids[v.Label()] = i + 1
+
+		attrs, _ := v.Metadata().(Attributes)
+		label := fmt.Sprint(v.Label())
+		if s, ok := attrs["label"].(string); ok {
+			label = s
+		}
+
+		_, _ = fmt.Fprintf(bw, "%d \"%s\"", i+1, strings.ReplaceAll(label, `"`, "'"))
+		for _, key := range []string{"x", "y", "z"} {
+			value, ok := attrs[key].(float64)
+			if !ok {
+				break
+			}
+
+			_, _ = fmt.Fprintf(bw, " %s", formatFloat(value))
+		}
+
+		writeParameters(bw, attrs, "label", "x", "y", "z")
+		_, _ = fmt.Fprintln(bw)
+	}
+
+	if g.IsDirected() {
+		_, _ = fmt.Fprintln(bw, "*Arcs")
+	} else {
+		_, _ = fmt.Fprintln(bw, "*Edges")
+	}
+
+	type pair struct{ source, target int }
+	var lines []pair
+	edges := make(map[pair]*gograph.Edge[T])
+	for _, edge := range g.AllEdges() {
+		p := pair{ids[edge.Source().Label()], ids[edge.Destination().Label()]}
+		if !g.IsDirected() && p.source > p.target {
+			continue
+		}
+
+		lines = append(lines, p)
+		edges[p] = edge
+	}
+
+	// AllEdges has no order, so the lines are sorted for a stable output.
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].source != lines[j].source {
+			return lines[i].source < lines[j].source
+		}
+
+		return lines[i].target < lines[j].target
+	})
+
+	for _, p := range lines {
+		_, _ = fmt.Fprintf(bw, "%d %d", p.source, p.target)
+		if g.IsWeighted() {
+			_, _ = fmt.Fprintf(bw, " %s", formatFloat(edges[p].Weight()))
+		}
+
+		attrs, _ := edges[p].Metadata().(Attributes)
+		writeParameters(bw, attrs)
+		_, _ = fmt.Fprintln(bw)
+	}
+
+	return bw.Flush()
+}
+
+// writeParameters writes the attributes, except the skipped keys, as
+// "name value" pairs in the order of their names. The values with spaces
+// are quoted.
+func writeParameters(w io.Writer, attrs Attributes, skip ...string) {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		if !slices.Contains(skip, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := strings.ReplaceAll(fmt.Sprint(attrs[key]), `"`, "'")
+		if value == "" || strings.ContainsAny(value, " \t") {
+			value = `"` + value + `"`
+		}
+
+		_, _ = fmt.Fprintf(w, " %s %s", key, value)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
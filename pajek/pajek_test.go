@@ -0,0 +1,259 @@
+package pajek
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestRead(t *testing.T) {
+	input := `% a small network
+*Network sample
+*Vertices 4
+1 "New York" 0.1 0.2 0.5 ic Red
+2 "Boston"
+3 Chicago
+*Arcs
+1 2 2.5 c Blue
+*Edges
+2 3
+*Arcslist
+3 1 4
+`
+	g, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsDirected() || !g.IsWeighted() {
+		t.Errorf("Expected directed weighted graph")
+	}
+
+	if g.Order() != 4 {
+		t.Errorf("Expected 4 vertices, but got %d", g.Order())
+	}
+
+	attrs, _ := g.GetVertexByID(1).Metadata().(Attributes)
+	if attrs["label"] != "New York" || attrs["x"] != 0.1 || attrs["z"] != 0.5 || attrs["ic"] != "Red" {
+		t.Errorf("Unexpected vertex attributes %+v", attrs)
+	}
+
+	if g.GetVertexByID(4).Metadata() != nil {
+		t.Errorf("Expected no metadata for unlisted vertex, but got %+v", g.GetVertexByID(4).Metadata())
+	}
+
+	edge := g.GetEdge(g.GetVertexByID(1), g.GetVertexByID(2))
+	if edge == nil || edge.Weight() != 2.5 {
+		t.Fatalf("Expected arc 1 -> 2 with weight 2.5, but got %+v", edge)
+	}
+
+	if edgeAttrs, _ := edge.Metadata().(Attributes); edgeAttrs["c"] != "Blue" {
+		t.Errorf("Expected edge color, but got %+v", edge.Metadata())
+	}
+
+	if g.ContainsEdge(g.GetVertexByID(2), g.GetVertexByID(1)) {
+		t.Errorf("Expected no arc 2 -> 1")
+	}
+
+	// the undirected edge is added in both directions.
+	if !g.ContainsEdge(g.GetVertexByID(2), g.GetVertexByID(3)) || !g.ContainsEdge(g.GetVertexByID(3), g.GetVertexByID(2)) {
+		t.Errorf("Expected edge 2 - 3 in both directions")
+	}
+
+	if g.Size() != 5 {
+		t.Errorf("Expected 5 edges, but got %d", g.Size())
+	}
+}
+
+func TestRead_Undirected(t *testing.T) {
+	input := "*vertices 3\n*edges\n1 2\n2 3\n1 2\n"
+	g, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if g.IsDirected() || g.IsWeighted() {
+		t.Errorf("Expected undirected unweighted graph")
+	}
+
+	if !g.ContainsEdge(g.GetVertexByID(3), g.GetVertexByID(2)) {
+		t.Errorf("Expected edge 3 - 2")
+	}
+}
+
+func TestRead_Matrix(t *testing.T) {
+	input := "*Vertices 2\n*Matrix\n0 3\n1 0\n"
+	g, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsDirected() || !g.IsWeighted() {
+		t.Errorf("Expected directed weighted graph")
+	}
+
+	if edge := g.GetEdge(g.GetVertexByID(1), g.GetVertexByID(2)); edge == nil || edge.Weight() != 3 {
+		t.Errorf("Expected arc 1 -> 2 with weight 3, but got %+v", edge)
+	}
+
+	if !g.ContainsEdge(g.GetVertexByID(2), g.GetVertexByID(1)) {
+		t.Errorf("Expected arc 2 -> 1")
+	}
+}
+
+func TestRead_MixedWeights(t *testing.T) {
+	g, err := Read(strings.NewReader("*Vertices 3\n*Arcs\n1 2 5\n2 3\n*Arcslist\n1 3\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsWeighted() {
+		t.Errorf("Expected weighted graph")
+	}
+
+	expected := map[[2]int]float64{{1, 2}: 5, {2, 3}: 1, {1, 3}: 1}
+	for e, weight := range expected {
+		edge := g.GetEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+		if edge == nil || edge.Weight() != weight {
+			t.Errorf("Expected arc %d -> %d with weight %g, but got %+v", e[0], e[1], weight, edge)
+		}
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	inputs := []string{
+		"",
+		"*Arcs\n1 2\n",
+		"*Vertices x\n",
+		"*Vertices -1\n",
+		"*Vertices 2000000000\n",
+		"*Vertices 2\n*Arcs\n1 3\n",
+		"*Vertices 2\n*Arcs\n1\n",
+		"*Vertices 2\n3 \"a\"\n",
+		"*Vertices 2\n1 \"unterminated\n",
+		"*Vertices 2\n*Matrix\n0 1 1\n",
+		"*Vertices 2\n*Unknown\n",
+		"1 2\n",
+	}
+
+	for _, input := range inputs {
+		if _, err := Read(strings.NewReader(input)); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected error %s for %q, but got %v", ErrInvalidFormat, input, err)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+	vA := g.AddVertexByLabel("A", gograph.WithVertexMetadata(Attributes{"label": "Alpha", "x": 0.5, "y": 1.0}))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vB, vA, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(0.5))
+
+	var buf bytes.Buffer
+	if err := Write(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `*Vertices 3
+1 "Alpha" 0.5 1
+2 "B"
+3 "C"
+*Edges
+1 2 2
+2 3 0.5
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	v1 := g.AddVertexByLabel(10)
+	v2 := g.AddVertexByLabel(20)
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v2)
+
+	var buf bytes.Buffer
+	if err := Write(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	clone, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !clone.IsDirected() || clone.IsWeighted() {
+		t.Errorf("Expected directed unweighted graph")
+	}
+
+	if clone.Size() != 2 || !clone.ContainsEdge(clone.GetVertexByID(1), clone.GetVertexByID(2)) ||
+		!clone.ContainsEdge(clone.GetVertexByID(2), clone.GetVertexByID(2)) {
+		t.Errorf("Expected arcs 1 -> 2 and 2 -> 2, but got %d edges", clone.Size())
+	}
+
+	if attrs, _ := clone.GetVertexByID(2).Metadata().(Attributes); attrs["label"] != "20" {
+		t.Errorf("Expected label 20, but got %+v", attrs)
+	}
+}
+
+func TestWrite_RoundTripAttributes(t *testing.T) {
+	input := `*Vertices 3
+1 "Alpha" 0.5 1 bc Black ic Red
+2 "Beta" ic "Light Blue"
+3 "Gamma"
+*Arcs
+1 2 2 c Blue
+2 3 1.5
+*Edges
+1 3 1 l "a b" p Dashed
+`
+	g, err := Read(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err = Write(&buf, g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `*Vertices 3
+1 "Alpha" 0.5 1 bc Black ic Red
+2 "Beta" ic "Light Blue"
+3 "Gamma"
+*Arcs
+1 2 2 c Blue
+1 3 1 l "a b" p Dashed
+2 3 1.5
+3 1 1 l "a b" p Dashed
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+
+	clone, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, v := range g.GetAllVertices() {
+		if !reflect.DeepEqual(v.Metadata(), clone.GetVertexByID(v.Label()).Metadata()) {
+			t.Errorf("Expected vertex attributes %+v, but got %+v", v.Metadata(), clone.GetVertexByID(v.Label()).Metadata())
+		}
+	}
+
+	for _, edge := range g.AllEdges() {
+		e := clone.GetEdge(clone.GetVertexByID(edge.Source().Label()), clone.GetVertexByID(edge.Destination().Label()))
+		if e == nil || e.Weight() != edge.Weight() || !reflect.DeepEqual(edge.Metadata(), e.Metadata()) {
+			t.Errorf("Expected edge %+v, but got %+v", edge, e)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package path
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// AllSimplePaths creates an iterator over the simple paths from the source
+// vertex to the target vertex, which don't visit a vertex twice. The paths
+// are found by a depth-first search, which follows the neighbors in the
+// order of gograph.Vertex.Neighbors, and each path is computed when it is
+// requested. The maxLen cuts off the paths with more than maxLen edges,
+// and a negative maxLen doesn't limit them.
+//
+// The number of simple paths may grow exponentially with the size of the
+// graph, and a search may explore many dead ends between two paths, so
+// the cutoff keeps the search bounded.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph.
+func AllSimplePaths[T comparable](g gograph.Graph[T], source, target T, maxLen int) (PathIterator[T], error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	s := &simplePathIterator[T]{
+		graph:  g,
+		source: source,
+		target: target,
+		maxLen: maxLen,
+		follow: func(_, _ *gograph.Vertex[T]) bool { return true },
+	}
+
+	s.Reset()
+	return s, nil
+}
+
+// AllShortestPaths creates an iterator over all the shortest paths from
+// the source vertex to the target vertex, which have the same cost, in a
+// weighted graph with non-negative edge weights. Dijkstra's algorithm
+// finds the distances from the source, and the paths only follow the
+// tight edges, whose weight is the difference of the distances of their
+// vertices, towards the vertices that reach the target. If a cycle of
+// zero weight edges makes infinitely many shortest paths, only the simple
+// ones are returned.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, and ErrNegativeWeight if the graph has an edge with a
+// negative weight.
+func AllShortestPaths[T comparable](g gograph.Graph[T], source, target T) (PathIterator[T], error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if err := checkNonNegative(g); err != nil {
+		return nil, err
+	}
+
+	dist := dijkstra(g, source)
+	tight := func(from, to *gograph.Vertex[T]) bool {
+		d := dist[from.Label()].dist + g.GetEdge(from, to).Weight()
+
+		// the tolerance absorbs the rounding errors of the sums.
+		return dist[from.Label()].dist != math.MaxFloat64 &&
+			math.Abs(d-dist[to.Label()].dist) <= 1e-9*math.Max(1, math.Abs(d))
+	}
+
+	// the vertices that reach the target along the tight edges.
+	incoming := gograph.NewReverseIndex(g)
+	reaches := map[T]bool{target: true}
+	queue := []T{target}
+	for len(queue) > 0 {
+		label := queue[0]
+		queue = queue[1:]
+		for _, edge := range incoming.IncomingEdges(label) {
+			if !reaches[edge.Source().Label()] && tight(edge.Source(), edge.Destination()) {
+				reaches[edge.Source().Label()] = true
+				queue = append(queue, edge.Source().Label())
+			}
+		}
+	}
+
+	s := &simplePathIterator[T]{
+		graph:  g,
+		source: source,
+		target: target,
+		maxLen: -1,
+		follow: func(from, to *gograph.Vertex[T]) bool {
+			return reaches[to.Label()] && tight(from, to)
+		},
+	}
+
+	s.Reset()
+	return s, nil
+}
+
+// CountPaths returns the number of paths from the source vertex to the
+// target vertex in a directed acyclic graph. It computes the number of
+// paths to each vertex in the topological order, as the sum of the
+// numbers of paths to its predecessors, in O(V + E) time, without
+// enumerating the paths. The number may grow exponentially with the size
+// of the graph, so it is a big.Int.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, ErrNotDirected if the graph is undirected, and
+// gograph.ErrDAGHasCycle if the graph has a cycle.
+func CountPaths[T comparable](g gograph.Graph[T], source, target T) (*big.Int, error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	sorted, err := topologicalOrder(g)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[T]*big.Int{source: big.NewInt(1)}
+	for _, v := range sorted {
+		count, ok := counts[v.Label()]
+		if !ok {
+			continue
+		}
+
+		if v.Label() == target {
+			return count, nil
+		}
+
+		for _, neighbor := range v.Neighbors() {
+			if _, ok := counts[neighbor.Label()]; !ok {
+				counts[neighbor.Label()] = new(big.Int)
+			}
+
+			counts[neighbor.Label()].Add(counts[neighbor.Label()], count)
+		}
+	}
+
+	return new(big.Int), nil
+}
+
+// pathFrame is a vertex of the current path of the search, and the next
+// neighbor to follow.
+type pathFrame[T comparable] struct {
+	vertex    *gograph.Vertex[T]
+	neighbors []*gograph.Vertex[T]
+	next      int
+}
+
+// simplePathIterator implements the PathIterator interface with a
+// depth-first search over the simple paths, which follows the edges that
+// the follow function accepts.
+type simplePathIterator[T comparable] struct {
+	graph  gograph.Graph[T]
+	source T
+	target T
+	maxLen int
+	follow func(from, to *gograph.Vertex[T]) bool
+	stack  []*pathFrame[T] // the current path from the source.
+	onPath map[T]bool      // the labels of the vertices on the current path.
+	next   *Path[T]        // the next path, if it is found.
+}
+
+// HasNext returns a boolean indicating whether there are more paths. It
+// finds the next path, if it is not found yet.
+func (s *simplePathIterator[T]) HasNext() bool {
+	if s.next == nil {
+		s.next = s.advance()
+	}
+
+	return s.next != nil
+}
+
+// Next returns the next simple path.
+// If the HasNext is false, returns nil.
+func (s *simplePathIterator[T]) Next() *Path[T] {
+	if !s.HasNext() {
+		return nil
+	}
+
+	p := s.next
+	s.next = nil
+	return p
+}
+
+// Iterate iterates through all the remaining paths and applies the given
+// function to each path. If the function returns an error, the iteration
+// stops and the error is returned.
+func (s *simplePathIterator[T]) Iterate(f func(p *Path[T]) error) error {
+	for s.HasNext() {
+		if err := f(s.Next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset resets the iterator by setting the initial state of the iterator.
+func (s *simplePathIterator[T]) Reset() {
+	s.stack = make([]*pathFrame[T], 0)
+	s.onPath = make(map[T]bool)
+	s.next = nil
+
+	source := s.graph.GetVertexByID(s.source)
+	if s.source == s.target {
+		// the only simple path to the source is the source itself.
+		s.next = s.path(source)
+		return
+	}
+
+	s.push(source)
+}
+
+// advance continues the search to the next path, or returns nil if there
+// is none.
+func (s *simplePathIterator[T]) advance() *Path[T] {
+	for len(s.stack) > 0 {
+		f := s.stack[len(s.stack)-1]
+		if f.next == len(f.neighbors) {
+			s.stack = s.stack[:len(s.stack)-1]
+			delete(s.onPath, f.vertex.Label())
+			continue
+		}
+
+		neighbor := f.neighbors[f.next]
+		f.next++
+		if s.onPath[neighbor.Label()] || !s.follow(f.vertex, neighbor) {
+			continue
+		}
+
+		if neighbor.Label() == s.target {
+			return s.path(neighbor)
+		}
+
+		s.push(neighbor)
+	}
+
+	return nil
+}
+
+// push adds the vertex to the current path, and only expands it if the
+// path can still reach the target within maxLen edges.
+func (s *simplePathIterator[T]) push(v *gograph.Vertex[T]) {
+	f := &pathFrame[T]{vertex: v}
+	if s.maxLen < 0 || len(s.stack) < s.maxLen {
+		f.neighbors = v.Neighbors()
+	}
+
+	s.stack = append(s.stack, f)
+	s.onPath[v.Label()] = true
+}
+
+// path returns the current path followed by the target vertex.
+func (s *simplePathIterator[T]) path(target *gograph.Vertex[T]) *Path[T] {
+	labels := make([]T, 0, len(s.stack)+1)
+	for _, f := range s.stack {
+		labels = append(labels, f.vertex.Label())
+	}
+
+	return newWeightedPath(s.graph, append(labels, target.Label()))
+}
@@ -0,0 +1,197 @@
+package path
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// collectPaths returns the labels of the remaining paths of the iterator.
+func collectPaths[T comparable](iter PathIterator[T]) [][]T {
+	var paths [][]T
+	_ = iter.Iterate(func(p *Path[T]) error {
+		labels, _ := pathLabels(p)
+		paths = append(paths, labels)
+		return nil
+	})
+
+	return paths
+}
+
+func newDiamondGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 1}, {"A", "C", 2}, {"B", "D", 2}, {"C", "D", 1},
+		{"B", "C", 1}, {"A", "D", 4}, {"D", "E", 1},
+	}
+
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+func TestAllSimplePaths(t *testing.T) {
+	g := newDiamondGraph()
+
+	iter, err := AllSimplePaths(g, "A", "D", -1)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := [][]string{
+		{"A", "B", "D"},
+		{"A", "B", "C", "D"},
+		{"A", "C", "D"},
+		{"A", "D"},
+	}
+
+	if paths := collectPaths(iter); !reflect.DeepEqual(expected, paths) {
+		t.Errorf("expected paths %v, but got %v", expected, paths)
+	}
+
+	if iter.HasNext() || iter.Next() != nil {
+		t.Errorf("expected no more paths")
+	}
+
+	iter.Reset()
+	if p := iter.Next(); p == nil || p.Cost != 3 {
+		t.Errorf("expected the path A, B, D with cost 3 after reset, but got %v", p)
+	}
+
+	iter, _ = AllSimplePaths(g, "A", "D", 2)
+	expected = [][]string{{"A", "B", "D"}, {"A", "C", "D"}, {"A", "D"}}
+	if paths := collectPaths(iter); !reflect.DeepEqual(expected, paths) {
+		t.Errorf("expected paths %v, but got %v", expected, paths)
+	}
+
+	iter, _ = AllSimplePaths(g, "A", "A", -1)
+	if paths := collectPaths(iter); !reflect.DeepEqual([][]string{{"A"}}, paths) {
+		t.Errorf("expected the path to the source to be the source, but got %v", paths)
+	}
+
+	iter, _ = AllSimplePaths(g, "E", "A", -1)
+	if iter.HasNext() {
+		t.Errorf("expected no paths")
+	}
+
+	if _, err = AllSimplePaths(g, "A", "X", -1); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestAllSimplePaths_Cycles(t *testing.T) {
+	g := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	iter, _ := AllSimplePaths(g, 1, 4, -1)
+	expected := [][]int{{1, 2, 3, 4}, {1, 3, 4}}
+	if paths := collectPaths(iter); !reflect.DeepEqual(expected, paths) {
+		t.Errorf("expected paths %v, but got %v", expected, paths)
+	}
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	g := newDiamondGraph()
+
+	iter, err := AllShortestPaths(g, "A", "E")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := [][]string{
+		{"A", "B", "D", "E"},
+		{"A", "B", "C", "D", "E"},
+		{"A", "C", "D", "E"},
+	}
+
+	if paths := collectPaths(iter); !reflect.DeepEqual(expected, paths) {
+		t.Errorf("expected paths %v, but got %v", expected, paths)
+	}
+
+	iter.Reset()
+	if p := iter.Next(); p == nil || p.Cost != 4 {
+		t.Errorf("expected a path with cost 4, but got %v", p)
+	}
+
+	iter, _ = AllShortestPaths(g, "E", "A")
+	if iter.HasNext() {
+		t.Errorf("expected no paths")
+	}
+
+	// a cycle of zero weight edges only gives the simple paths.
+	_, _ = g.AddEdge(g.GetVertexByID("C"), gograph.NewVertex("F"), gograph.WithEdgeWeight(0))
+	_, _ = g.AddEdge(g.GetVertexByID("F"), g.GetVertexByID("C"), gograph.WithEdgeWeight(0))
+	iter, _ = AllShortestPaths(g, "A", "D")
+	if paths := collectPaths(iter); len(paths) != 3 {
+		t.Errorf("expected 3 paths, but got %v", paths)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("E"), g.GetVertexByID("A"), gograph.WithEdgeWeight(-1))
+	if _, err = AllShortestPaths(g, "A", "E"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestCountPaths(t *testing.T) {
+	g := newDiamondGraph()
+
+	count, err := CountPaths(g, "A", "E")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if count.Cmp(big.NewInt(4)) != 0 {
+		t.Errorf("expected 4 paths, but got %s", count)
+	}
+
+	if count, _ = CountPaths(g, "E", "A"); count.Sign() != 0 {
+		t.Errorf("expected no paths, but got %s", count)
+	}
+
+	if count, _ = CountPaths(g, "B", "B"); count.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("expected 1 path, but got %s", count)
+	}
+
+	// a chain of 100 diamonds has 2^100 paths.
+	chain := gograph.New[int](gograph.Directed())
+	for i := 0; i < 300; i += 3 {
+		_, _ = chain.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+1))
+		_, _ = chain.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+2))
+		_, _ = chain.AddEdge(chain.GetVertexByID(i+1), gograph.NewVertex(i+3))
+		_, _ = chain.AddEdge(chain.GetVertexByID(i+2), chain.GetVertexByID(i+3))
+	}
+
+	expected := new(big.Int).Lsh(big.NewInt(1), 100)
+	if count, _ = CountPaths(chain, 0, 300); count.Cmp(expected) != 0 {
+		t.Errorf("expected %s paths, but got %s", expected, count)
+	}
+
+	if _, err = CountPaths(g, "A", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err = CountPaths(gograph.New[int](), 1, 1); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	undirected := gograph.New[int]()
+	_, _ = undirected.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	if _, err = CountPaths(undirected, 1, 2); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("expected error %s, but got %v", ErrNotDirected, err)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("E"), g.GetVertexByID("A"))
+	if _, err = CountPaths(g, "A", "E"); !errors.Is(err, gograph.ErrDAGHasCycle) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrDAGHasCycle, err)
+	}
+}
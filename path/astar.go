@@ -0,0 +1,135 @@
+package path
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+var ErrInadmissibleHeuristic = errors.New("heuristic overestimates the remaining cost")
+
+// Heuristic estimates the cost of the shortest path from the vertex v to
+// the goal vertex. A* finds a shortest path if the heuristic is
+// admissible, i.e. it never overestimates the cost.
+type Heuristic[T comparable] func(v, goal *gograph.Vertex[T]) float64
+
+// AStarOptionFunc represent an alias of function type that modifies the
+// specified A* options.
+type AStarOptionFunc func(options *AStarOptions)
+
+// AStarOptions represents the options of the A* search.
+type AStarOptions struct {
+	debug bool
+}
+
+// WithDebug returns an AStarOptionFunc that turns on the debug mode, where
+// AStar validates that the heuristic is admissible before the search. It
+// computes the shortest distance from every vertex to the goal, which
+// costs as much as a Dijkstra's search, so it is meant for tests and
+// development.
+func WithDebug() AStarOptionFunc {
+	return func(options *AStarOptions) {
+		options.debug = true
+	}
+}
+
+// AStar finds a shortest path from the start vertex to the goal vertex in
+// a weighted graph with non-negative edge weights. It is Dijkstra's
+// algorithm, where the priority of a vertex is its distance from the start
+// plus the heuristic estimate of its distance to the goal, so it expands
+// the vertices towards the goal first, and stops when the goal is taken
+// from the priority queue. With a good heuristic, such as the straight-line
+// distance on a road network, it expands far fewer vertices than Dijkstra.
+//
+// The path is the shortest one if the heuristic is admissible. The search
+// also accepts a heuristic that is admissible but not consistent, and
+// expands a vertex again when it finds a shorter path to it.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start or the goal vertex
+// doesn't exist in the graph, ErrNoPath if the goal is not reachable, and
+// ErrNegativeWeight if it finds an edge with a negative weight. In debug
+// mode, it returns ErrInadmissibleHeuristic if the heuristic overestimates
+// the distance from any vertex to the goal.
+func AStar[T comparable](
+	g gograph.Graph[T],
+	start, goal T,
+	heuristic Heuristic[T],
+	options ...AStarOptionFunc,
+) (*Path[T], error) {
+	var opts AStarOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	goalVertex := g.GetVertexByID(goal)
+	if g.GetVertexByID(start) == nil || goalVertex == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if opts.debug {
+		if err := validateHeuristic(g, goalVertex, heuristic); err != nil {
+			return nil, err
+		}
+	}
+
+	estimate := func(label T) float64 {
+		return heuristic(g.GetVertexByID(label), goalVertex)
+	}
+
+	labels, cost, err := bestFirst(gograph.AsImplicit(g), start, func(label T) bool {
+		return label == goal
+	}, estimate)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPath(g, labels, cost), nil
+}
+
+// validateHeuristic runs Dijkstra's algorithm from the goal along the
+// incoming edges, and checks the heuristic of every vertex that reaches
+// the goal against its distance to the goal.
+func validateHeuristic[T comparable](g gograph.Graph[T], goal *gograph.Vertex[T], heuristic Heuristic[T]) error {
+	incoming := gograph.NewReverseIndex(g)
+
+	dist := map[T]float64{goal.Label(): 0}
+	done := make(map[T]bool)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(goal, 0))
+	for pq.Len() > 0 {
+		curr := pq.Pop()
+		v := curr.Vertex()
+		if done[v.Label()] {
+			continue
+		}
+
+		done[v.Label()] = true
+
+		// the tolerance absorbs the rounding errors of the heuristic.
+		d := dist[v.Label()]
+		if h := heuristic(v, goal); h > d+1e-9*math.Max(1, d) {
+			return fmt.Errorf("%w: vertex %v is %v away from the goal, but the estimate is %v",
+				ErrInadmissibleHeuristic, v.Label(), d, h)
+		}
+
+		for _, edge := range incoming.IncomingEdges(v.Label()) {
+			if edge.Weight() < 0 {
+				return ErrNegativeWeight
+			}
+
+			source := edge.Source()
+			if alt := d + edge.Weight(); !done[source.Label()] {
+				if old, ok := dist[source.Label()]; !ok || alt < old {
+					dist[source.Label()] = alt
+					pq.Push(util.NewVertexWithPriority(source, alt))
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package path
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// newRoadGrid returns an undirected 10x10 grid, where the label of the
+// cell (x, y) is 10x + y, and its metadata is the Point (x, y). The
+// cells of the wall x = 5 are blocked, except the cell (5, 9).
+func newRoadGrid() gograph.Graph[int] {
+	g := gograph.New[int](gograph.Weighted())
+	for x := range 10 {
+		for y := range 10 {
+			g.AddVertexByLabel(10*x+y, gograph.WithVertexMetadata(Point{X: float64(x), Y: float64(y)}))
+		}
+	}
+
+	for x := range 10 {
+		for y := range 10 {
+			if x == 5 && y != 9 {
+				continue
+			}
+
+			if x < 9 && (x != 4 || y == 9) {
+				_, _ = g.AddEdge(g.GetVertexByID(10*x+y), g.GetVertexByID(10*(x+1)+y), gograph.WithEdgeWeight(1))
+			}
+
+			if y < 9 && x != 5 {
+				_, _ = g.AddEdge(g.GetVertexByID(10*x+y), g.GetVertexByID(10*x+y+1), gograph.WithEdgeWeight(1))
+			}
+		}
+	}
+
+	return g
+}
+
+func TestAStar(t *testing.T) {
+	g := newRoadGrid()
+
+	for _, heuristic := range []Heuristic[int]{ManhattanDistance[int], EuclideanDistance[int]} {
+		p, err := AStar(g, 0, 90, heuristic, WithDebug())
+		if err != nil {
+			t.Fatalf("expected no error, but got %s", err)
+		}
+
+		// around the wall: 9 up, 9 right and 9 down.
+		if p.Cost != 27 || len(p.Vertices) != 28 || len(p.Edges) != 27 {
+			t.Errorf("expected a path with cost 27, but got %d vertices with cost %v", len(p.Vertices), p.Cost)
+		}
+
+		if p.Vertices[0].Label() != 0 || p.Vertices[27].Label() != 90 {
+			t.Errorf("expected a path from 0 to 90, but got %v", p.Vertices)
+		}
+	}
+
+	paths, _ := DijkstraPaths(g, 0)
+	for _, goal := range []int{0, 9, 44, 59, 77, 99} {
+		p, err := AStar(g, 0, goal, ManhattanDistance[int])
+		if err != nil || p.Cost != paths.Distance(goal) {
+			t.Errorf("%d: expected cost %v, but got %v, %v", goal, paths.Distance(goal), p, err)
+		}
+	}
+}
+
+func TestAStar_Expansions(t *testing.T) {
+	g := newRoadGrid()
+
+	// count the vertices whose heuristic is computed, which are the
+	// generated vertices.
+	generated := func(heuristic Heuristic[int]) int {
+		seen := make(map[int]bool)
+		_, err := AStar(g, 0, 9, func(v, goal *gograph.Vertex[int]) float64 {
+			seen[v.Label()] = true
+			return heuristic(v, goal)
+		})
+		if err != nil {
+			t.Fatalf("expected no error, but got %s", err)
+		}
+
+		return len(seen)
+	}
+
+	zero := func(_, _ *gograph.Vertex[int]) float64 { return 0 }
+	if manhattan, dijkstra := generated(ManhattanDistance[int]), generated(zero); manhattan >= dijkstra {
+		t.Errorf("expected A* to generate fewer vertices than Dijkstra, but got %d and %d", manhattan, dijkstra)
+	}
+}
+
+func TestAStar_Errors(t *testing.T) {
+	g := newRoadGrid()
+
+	if _, err := AStar(g, 0, 100, ManhattanDistance[int]); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	// the cells of the wall are not connected.
+	if _, err := AStar(g, 0, 50, ManhattanDistance[int]); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	overestimate := func(v, goal *gograph.Vertex[int]) float64 {
+		return 2 * ManhattanDistance(v, goal)
+	}
+
+	if _, err := AStar(g, 0, 90, overestimate); err != nil {
+		t.Errorf("expected no error without the debug mode, but got %s", err)
+	}
+
+	if _, err := AStar(g, 0, 90, overestimate, WithDebug()); !errors.Is(err, ErrInadmissibleHeuristic) {
+		t.Errorf("expected error %s, but got %v", ErrInadmissibleHeuristic, err)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID(0), g.GetVertexByID(50), gograph.WithEdgeWeight(-1))
+	if _, err := AStar(g, 0, 90, ManhattanDistance[int]); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
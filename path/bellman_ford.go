@@ -2,17 +2,43 @@ package path
 
 import (
 	"errors"
+	"fmt"
 	"math"
 
 	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/traverse"
 )
 
 var (
 	ErrNegativeWeightCycle = errors.New("graph contains negative weight cycle")
+	ErrNegativeWeight      = errors.New("graph contains negative weight edge")
 	ErrNotDirected         = errors.New("graph is not directed")
 	ErrNotWeighted         = errors.New("graph is not weighted")
+
+	// ErrNoPath is the error of traverse, so the searches of both packages
+	// report an unreachable target with the same error.
+	ErrNoPath = traverse.ErrNoPath
 )
 
+// NegativeCycleError is the error of a negative weight cycle, which has
+// the vertices of the cycle. It wraps ErrNegativeWeightCycle, so it can
+// be checked with errors.Is, and errors.As gives the cycle.
+type NegativeCycleError[T comparable] struct {
+	// Cycle has the labels of the cycle vertices in the order of its
+	// edges. The last vertex has an edge to the first one.
+	Cycle []T
+}
+
+// Error returns the error message with the cycle vertices.
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("%s: %v", ErrNegativeWeightCycle, e.Cycle)
+}
+
+// Unwrap returns ErrNegativeWeightCycle.
+func (e *NegativeCycleError[T]) Unwrap() error {
+	return ErrNegativeWeightCycle
+}
+
 // BellmanFord finds the shortest path from a source vertex to all other vertices
 // in a weighted graph, even in the presence of negative weight edges, as long as
 // there are no negative weight cycle.
@@ -38,21 +64,76 @@ var (
 //		distances from the source vertex to all other vertices. If there is a negative weight
 //		cycle, the algorithm typically returns an indication of this fact.
 //
+// The relaxation stops early when an iteration doesn't reduce any distance.
+//
 // The time complexity of the Bellman-Ford algorithm is O(V*E), where V is the number of vertices
 // and E is the number of edges.
+//
+// It returns ErrNotWeighted if the graph is not weighted, and ErrNotDirected if the graph is
+// undirected and has a negative weight edge, since an undirected negative weight edge is a
+// negative weight cycle by itself. If a negative weight cycle is reachable from the start vertex,
+// it returns a *NegativeCycleError with the vertices of the cycle.
 func BellmanFord[T comparable](g gograph.Graph[T], start T) (map[T]float64, error) {
+	dist, _, err := bellmanFord(g, start)
+	return dist, err
+}
+
+// BellmanFordPaths runs the Bellman-Ford algorithm like BellmanFord, and
+// returns the shortest paths from the starting vertex, which also rebuild
+// the paths and the shortest-path tree.
+//
+// It returns the errors of BellmanFord, and gograph.ErrVertexDoesNotExist
+// if the starting vertex doesn't exist in the graph.
+func BellmanFordPaths[T comparable](g gograph.Graph[T], start T) (*ShortestPaths[T], error) {
+	if err := validateBellmanFord(g); err != nil {
+		return nil, err
+	}
+
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	dist, prev, err := bellmanFord(g, start)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := &ShortestPaths[T]{graph: g, source: start, dist: dist, prev: prev}
+	for label, d := range dist {
+		if math.IsInf(d, 1) {
+			delete(dist, label)
+		}
+	}
+
+	return paths, nil
+}
+
+// validateBellmanFord returns the error of a graph that the Bellman-Ford
+// algorithm doesn't accept.
+func validateBellmanFord[T comparable](g gograph.Graph[T]) error {
 	if !g.IsWeighted() {
-		return nil, ErrNotWeighted
+		return ErrNotWeighted
 	}
 
-	if !g.IsDirected() {
-		return nil, ErrNotDirected
+	if !g.IsDirected() && checkNonNegative(g) != nil {
+		return ErrNotDirected
+	}
+
+	return nil
+}
+
+// bellmanFord returns the distances from the start vertex, and the
+// predecessor of each vertex whose distance was reduced.
+func bellmanFord[T comparable](g gograph.Graph[T], start T) (map[T]float64, map[T]T, error) {
+	if err := validateBellmanFord(g); err != nil {
+		return nil, nil, err
 	}
 
 	vertices := g.GetAllVertices()
 	edges := g.AllEdges()
 
 	dist := make(map[T]float64)
+	prev := make(map[T]T)
 	maxValue := math.Inf(1)
 	for _, v := range vertices {
 		dist[v.Label()] = maxValue
@@ -60,21 +141,29 @@ func BellmanFord[T comparable](g gograph.Graph[T], start T) (map[T]float64, erro
 
 	dist[start] = 0
 	for i := 1; i < len(vertices); i++ {
+		changed := false
 		for _, edge := range edges {
 			weight := edge.Weight()
 			if dist[edge.Source().Label()] != maxValue &&
 				dist[edge.Source().Label()]+weight < dist[edge.Destination().Label()] {
 				dist[edge.Destination().Label()] = dist[edge.Source().Label()] + weight
+				prev[edge.Destination().Label()] = edge.Source().Label()
+				changed = true
 			}
 		}
+
+		if !changed {
+			return dist, prev, nil
+		}
 	}
 
 	for _, edge := range edges {
 		if dist[edge.Source().Label()] != maxValue &&
 			dist[edge.Source().Label()]+edge.Weight() < dist[edge.Destination().Label()] {
-			return nil, ErrNegativeWeightCycle
+			prev[edge.Destination().Label()] = edge.Source().Label()
+			return nil, nil, &NegativeCycleError[T]{Cycle: predecessorCycle(prev, edge.Destination().Label())}
 		}
 	}
 
-	return dist, nil
+	return dist, prev, nil
 }
@@ -2,6 +2,9 @@ package path
 
 import (
 	"errors"
+	"math"
+	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/hmdsefi/gograph"
@@ -107,6 +110,67 @@ func TestBellmanFord_NegativeCycle(t *testing.T) {
 	if !errors.Is(err, ErrNegativeWeightCycle) {
 		t.Errorf("Expected error \"%s\", but got \"%s\"", ErrNegativeWeightCycle, err)
 	}
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"E", "D", "F"}, cycleErr.Cycle) {
+		t.Errorf("Expected the cycle E, D, F, but got %v", err)
+	}
+}
+
+// isRotation returns true if the cycle is the expected cycle, starting
+// from any of its vertices.
+func isRotation(expected, cycle []string) bool {
+	if len(expected) != len(cycle) {
+		return false
+	}
+
+	for i := range cycle {
+		if reflect.DeepEqual(expected, append(slices.Clone(cycle[i:]), cycle[:i]...)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestBellmanFord_Arbitrage(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	// the weight of an exchange is -log(rate), so a cycle whose rates
+	// multiply to more than 1 is a negative weight cycle.
+	rates := []struct {
+		from, to string
+		rate     float64
+	}{
+		{"USD", "EUR", 0.9}, {"EUR", "USD", 1.1}, {"EUR", "GBP", 0.8},
+		{"GBP", "USD", 1.4}, {"USD", "JPY", 150}, {"JPY", "USD", 0.0066},
+	}
+
+	for _, r := range rates {
+		_, _ = g.AddEdge(gograph.NewVertex(r.from), gograph.NewVertex(r.to), gograph.WithEdgeWeight(-math.Log(r.rate)))
+	}
+
+	_, err := BellmanFord(g, "JPY")
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected a negative cycle, but got %v", err)
+	}
+
+	product := 1.0
+	for i, label := range cycleErr.Cycle {
+		next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+		edge := g.GetEdge(g.GetVertexByID(label), g.GetVertexByID(next))
+		if edge == nil {
+			t.Fatalf("Expected the cycle edge %s -> %s", label, next)
+		}
+
+		product *= math.Exp(-edge.Weight())
+	}
+
+	if product <= 1 {
+		t.Errorf("Expected the rates of the cycle %v to multiply to more than 1, but got %f", cycleErr.Cycle, product)
+	}
 }
 
 func TestBellmanFord_NotWeighted(t *testing.T) {
@@ -129,7 +193,7 @@ func TestBellmanFord_NotWeighted(t *testing.T) {
 	}
 }
 
-func TestBellmanFord_NotDirected(t *testing.T) {
+func TestBellmanFord_Undirected(t *testing.T) {
 	g := gograph.New[string](gograph.Weighted())
 
 	vA := g.AddVertexByLabel("A")
@@ -139,6 +203,27 @@ func TestBellmanFord_NotDirected(t *testing.T) {
 	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
 	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
 
+	dist, err := BellmanFord(g, vC.Label())
+	if err != nil {
+		t.Errorf("Expected no errors, but get an err: %s", err)
+	}
+
+	if dist[vA.Label()] != 6 {
+		t.Errorf("Expected %s to %s shortest distance to be %d, but got %f", vC.Label(), vA.Label(), 6, dist[vA.Label()])
+	}
+}
+
+func TestBellmanFord_NotDirected(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	// an undirected negative weight edge is a negative weight cycle.
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(-1))
+
 	_, err := BellmanFord(g, vA.Label())
 	if err == nil {
 		t.Errorf("Expected error, but got nil")
@@ -0,0 +1,206 @@
+package path
+
+import (
+	"math"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// BidirectionalDijkstraOptionFunc represent an alias of function type
+// that modifies the specified bidirectional Dijkstra options.
+type BidirectionalDijkstraOptionFunc[T comparable] func(options *BidirectionalDijkstraOptions[T])
+
+// BidirectionalDijkstraOptions represents the options of the bidirectional
+// Dijkstra's algorithm.
+type BidirectionalDijkstraOptions[T comparable] struct {
+	index *gograph.ReverseIndex[T]
+}
+
+// WithReverseIndex returns a BidirectionalDijkstraOptionFunc that sets
+// the incoming edges that the backward search follows, so the queries on
+// the same graph don't collect them again. The index must be built from
+// the current graph.
+func WithReverseIndex[T comparable](index *gograph.ReverseIndex[T]) BidirectionalDijkstraOptionFunc[T] {
+	return func(options *BidirectionalDijkstraOptions[T]) {
+		options.index = index
+	}
+}
+
+// BidirectionalDijkstra finds a shortest path from the source vertex to
+// the target vertex in a weighted graph with non-negative edge weights.
+// It runs Dijkstra's algorithm forward from the source along the outgoing
+// edges, and backward from the target along the incoming edges, and
+// advances the search whose next vertex is closer. Each search explores a
+// disk of about half the distance, so together they settle far fewer
+// vertices than a search from the source.
+//
+// Every time a search relaxes an edge to a vertex that the other search
+// reached, the sum of the two distances is a candidate path. The search
+// stops when the smallest distances of the two queues add up to the best
+// candidate, since any path that is not found yet would be longer. It is
+// not enough to stop when a vertex is settled by both searches, because
+// the shortest path may go through a vertex that neither of them settled.
+//
+// Vertices only store their outgoing edges, so the first backward step
+// builds a gograph.ReverseIndex of the graph, which takes O(E) time on
+// every query and may cost more than the search itself. The queries on
+// the same graph should share one index through WithReverseIndex.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, ErrNoPath if the target is not reachable, and
+// ErrNegativeWeight if it finds an edge with a negative weight.
+func BidirectionalDijkstra[T comparable](
+	g gograph.Graph[T],
+	source, target T,
+	options ...BidirectionalDijkstraOptionFunc[T],
+) (*Path[T], error) {
+	sourceVertex := g.GetVertexByID(source)
+	targetVertex := g.GetVertexByID(target)
+	if sourceVertex == nil || targetVertex == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	forward := newDijkstraSearch(sourceVertex, func(v *gograph.Vertex[T]) []*gograph.Edge[T] {
+		edges := make([]*gograph.Edge[T], len(v.Neighbors()))
+		for i, neighbor := range v.Neighbors() {
+			edges[i] = g.GetEdge(v, neighbor)
+		}
+
+		return edges
+	}, (*gograph.Edge[T]).Destination)
+
+	opts := BidirectionalDijkstraOptions[T]{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	backward := newDijkstraSearch(targetVertex, func(v *gograph.Vertex[T]) []*gograph.Edge[T] {
+		if opts.index == nil {
+			opts.index = gograph.NewReverseIndex(g)
+		}
+
+		return opts.index.IncomingEdges(v.Label())
+	}, (*gograph.Edge[T]).Source)
+
+	// best is the cost of the shortest path found so far, through the
+	// meeting vertex.
+	best := math.Inf(1)
+	var meeting T
+	if source == target {
+		best, meeting = 0, source
+	}
+
+	for {
+		f, b := forward.top(), backward.top()
+		if f+b >= best || math.IsInf(f, 1) || math.IsInf(b, 1) {
+			break
+		}
+
+		search, other := forward, backward
+		if b < f {
+			search, other = backward, forward
+		}
+
+		reached, err := search.settle()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, label := range reached {
+			if d, ok := other.dist[label]; ok && search.dist[label]+d < best {
+				best, meeting = search.dist[label]+d, label
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		return nil, ErrNoPath
+	}
+
+	// the forward predecessors lead from the meeting vertex back to the
+	// source, and the backward ones lead on to the target.
+	labels := pathTo(forward.prev, source, meeting)
+	for label := meeting; label != target; {
+		label = backward.prev[label]
+		labels = append(labels, label)
+	}
+
+	return newPath(g, labels, best), nil
+}
+
+// dijkstraSearch is one direction of the bidirectional Dijkstra. It
+// follows the edges that the edges function returns, to the vertex that
+// the next function returns.
+type dijkstraSearch[T comparable] struct {
+	pq      *util.VertexPriorityQueue[T]
+	dist    map[T]float64
+	prev    map[T]T
+	settled map[T]bool
+	edges   func(v *gograph.Vertex[T]) []*gograph.Edge[T]
+	next    func(e *gograph.Edge[T]) *gograph.Vertex[T]
+}
+
+func newDijkstraSearch[T comparable](
+	start *gograph.Vertex[T],
+	edges func(v *gograph.Vertex[T]) []*gograph.Edge[T],
+	next func(e *gograph.Edge[T]) *gograph.Vertex[T],
+) *dijkstraSearch[T] {
+	s := &dijkstraSearch[T]{
+		pq:      util.NewVertexPriorityQueue[T](),
+		dist:    map[T]float64{start.Label(): 0},
+		prev:    make(map[T]T),
+		settled: make(map[T]bool),
+		edges:   edges,
+		next:    next,
+	}
+
+	s.pq.Push(util.NewVertexWithPriority(start, 0))
+	return s
+}
+
+// top drops the stale entries of the queue, and returns the distance of
+// the next vertex to settle, or positive infinity if there is none.
+func (s *dijkstraSearch[T]) top() float64 {
+	for s.pq.Len() > 0 {
+		curr := s.pq.Peek()
+		if !s.settled[curr.Vertex().Label()] {
+			return curr.Priority()
+		}
+
+		s.pq.Pop()
+	}
+
+	return math.Inf(1)
+}
+
+// settle settles the next vertex, relaxes its edges, and returns the
+// labels of the vertices whose distance is reduced.
+func (s *dijkstraSearch[T]) settle() ([]T, error) {
+	curr := s.pq.Pop().Vertex()
+	s.settled[curr.Label()] = true
+
+	var reached []T
+	for _, edge := range s.edges(curr) {
+		if edge.Weight() < 0 {
+			return nil, ErrNegativeWeight
+		}
+
+		v := s.next(edge)
+		if s.settled[v.Label()] {
+			continue
+		}
+
+		alt := s.dist[curr.Label()] + edge.Weight()
+		if d, ok := s.dist[v.Label()]; ok && alt >= d {
+			continue
+		}
+
+		s.dist[v.Label()] = alt
+		s.prev[v.Label()] = curr.Label()
+		s.pq.Push(util.NewVertexWithPriority(v, alt))
+		reached = append(reached, v.Label())
+	}
+
+	return reached, nil
+}
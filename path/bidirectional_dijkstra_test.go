@@ -0,0 +1,115 @@
+package path
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vS := g.AddVertexByLabel("S")
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vX := g.AddVertexByLabel("X")
+	vT := g.AddVertexByLabel("T")
+
+	// both searches reach X first, but the shortest path goes through A
+	// and B.
+	_, _ = g.AddEdge(vS, vX, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vX, vT, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vS, vA, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vB, vT, gograph.WithEdgeWeight(3))
+
+	p, err := BidirectionalDijkstra(g, "S", "T")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	labels, weights := pathLabels(p)
+	if !reflect.DeepEqual([]string{"S", "A", "B", "T"}, labels) ||
+		!reflect.DeepEqual([]float64{3, 3, 3}, weights) || p.Cost != 9 {
+		t.Errorf("expected the path S, A, B, T with cost 9, but got %v %v with cost %v", labels, weights, p.Cost)
+	}
+
+	p, _ = BidirectionalDijkstra(g, "A", "A")
+	if len(p.Vertices) != 1 || len(p.Edges) != 0 || p.Cost != 0 {
+		t.Errorf("expected the path to the source to be the source, but got %v", p)
+	}
+
+	if _, err = BidirectionalDijkstra(g, "T", "S"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = BidirectionalDijkstra(g, "S", "Y"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, _ = g.AddEdge(vA, vX, gograph.WithEdgeWeight(-1))
+	if _, err = BidirectionalDijkstra(g, "S", "T"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestBidirectionalDijkstra_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for _, directed := range []bool{true, false} {
+		options := []gograph.GraphOptionFunc{gograph.Weighted()}
+		if directed {
+			options = append(options, gograph.Directed())
+		}
+
+		g := gograph.New[int](options...)
+		for i := range 60 {
+			g.AddVertexByLabel(i)
+		}
+
+		for range 180 {
+			from, to := rng.Intn(60), rng.Intn(60)
+			if from != to {
+				_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(rng.Intn(10))))
+			}
+		}
+
+		// the odd targets share one reverse index.
+		index := gograph.NewReverseIndex(g)
+		for source := range 60 {
+			paths, _ := DijkstraPaths(g, source)
+			for target := range 60 {
+				var options []BidirectionalDijkstraOptionFunc[int]
+				if target%2 == 1 {
+					options = append(options, WithReverseIndex(index))
+				}
+
+				p, err := BidirectionalDijkstra(g, source, target, options...)
+				if !paths.HasPathTo(target) {
+					if !errors.Is(err, ErrNoPath) {
+						t.Fatalf("%d -> %d: expected error %s, but got %v", source, target, ErrNoPath, err)
+					}
+
+					continue
+				}
+
+				if err != nil || p.Cost != paths.Distance(target) {
+					t.Fatalf("%d -> %d: expected cost %v, but got %v, %v", source, target, paths.Distance(target), p, err)
+				}
+
+				_, weights := pathLabels(p)
+				sum := 0.0
+				for _, w := range weights {
+					sum += w
+				}
+
+				if sum != p.Cost || p.Vertices[0].Label() != source || p.Vertices[len(p.Vertices)-1].Label() != target {
+					t.Fatalf("%d -> %d: expected a connected path with cost %v, but got %v", source, target, p.Cost, p)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package path
+
+import (
+	"math"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// slackTolerance absorbs the rounding errors of the schedule times, so a
+// task whose slack is a rounding error is still critical.
+const slackTolerance = 1e-9
+
+// Task is the schedule of a task of a project, which is a vertex of the
+// project graph.
+type Task[T comparable] struct {
+	Label          T       // the label of the vertex.
+	Duration       float64 // the duration of the task, which is the weight of the vertex.
+	EarliestStart  float64 // the earliest time the task can start.
+	EarliestFinish float64 // the earliest time the task can finish.
+	LatestStart    float64 // the latest time the task can start without delaying the project.
+	LatestFinish   float64 // the latest time the task can finish without delaying the project.
+	Slack          float64 // the time the task can be delayed without delaying the project.
+}
+
+// Critical returns true if the task has no slack, so any delay of the
+// task delays the project.
+func (t *Task[T]) Critical() bool {
+	return t.Slack <= slackTolerance*math.Max(1, math.Abs(t.LatestStart))
+}
+
+// Schedule is the result of the critical path method.
+type Schedule[T comparable] struct {
+	Tasks         map[T]*Task[T] // the schedule of each task.
+	Duration      float64        // the duration of the project, which is the latest earliest finish.
+	CriticalChain []*Task[T]     // a chain of critical tasks from the start to the end of the project.
+}
+
+// CriticalPath runs the critical path method (CPM) on a project graph,
+// which is a directed acyclic graph, such as an Acyclic graph. Each vertex
+// is a task, whose duration is the vertex weight (see
+// gograph.WithVertexWeight), and each edge means that the source task must
+// finish before the destination task starts. The edge weight is the lag
+// between them, which is 0 by default.
+//
+// Steps:
+//
+//  1. Forward Pass: In the topological order, the earliest start of a
+//     task is the latest earliest finish of its predecessors plus the
+//     lag, or 0 if it has none. The duration of the project is the latest
+//     earliest finish.
+//
+//  2. Backward Pass: In the reverse topological order, the latest finish
+//     of a task is the earliest latest start of its successors minus the
+//     lag, or the duration of the project if it has none.
+//
+//  3. Slack: The slack of a task is its latest start minus its earliest
+//     start. The tasks without slack are critical, and the critical chain
+//     follows the critical tasks that start as soon as their predecessor
+//     finishes, from the start to the end of the project. If there are
+//     several critical chains, it is the first one in the topological
+//     order.
+//
+// It takes O(V log V + E) time, since gograph.TopologySort sorts the
+// vertices by insertion order, which keeps the critical chain the same in
+// every run. It returns ErrNotDirected if the graph is undirected, and
+// gograph.ErrDAGHasCycle if the graph has a cycle.
+func CriticalPath[T comparable](g gograph.Graph[T]) (*Schedule[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	sorted, err := gograph.TopologySort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &Schedule[T]{Tasks: make(map[T]*Task[T], len(sorted))}
+	for _, v := range sorted {
+		schedule.Tasks[v.Label()] = &Task[T]{Label: v.Label(), Duration: v.Weight()}
+	}
+
+	// the forward pass
+	for _, v := range sorted {
+		task := schedule.Tasks[v.Label()]
+		task.EarliestFinish = task.EarliestStart + task.Duration
+		schedule.Duration = math.Max(schedule.Duration, task.EarliestFinish)
+
+		for _, neighbor := range v.Neighbors() {
+			next := schedule.Tasks[neighbor.Label()]
+			next.EarliestStart = math.Max(next.EarliestStart, task.EarliestFinish+g.GetEdge(v, neighbor).Weight())
+		}
+	}
+
+	// the backward pass
+	for i := len(sorted) - 1; i >= 0; i-- {
+		v := sorted[i]
+		task := schedule.Tasks[v.Label()]
+		task.LatestFinish = schedule.Duration
+		for _, neighbor := range v.Neighbors() {
+			next := schedule.Tasks[neighbor.Label()]
+			task.LatestFinish = math.Min(task.LatestFinish, next.LatestStart-g.GetEdge(v, neighbor).Weight())
+		}
+
+		task.LatestStart = task.LatestFinish - task.Duration
+		task.Slack = task.LatestStart - task.EarliestStart
+	}
+
+	schedule.CriticalChain = criticalChain(g, sorted, schedule.Tasks)
+	return schedule, nil
+}
+
+// criticalChain starts from the first critical task that starts at 0,
+// and follows the first critical successor that starts as soon as the
+// current task finishes. A critical task that doesn't end the project
+// always has such a successor, so the chain reaches the end.
+func criticalChain[T comparable](g gograph.Graph[T], sorted []*gograph.Vertex[T], tasks map[T]*Task[T]) []*Task[T] {
+	var curr *gograph.Vertex[T]
+	for _, v := range sorted {
+		if task := tasks[v.Label()]; task.Critical() && task.EarliestStart <= slackTolerance {
+			curr = v
+			break
+		}
+	}
+
+	var chain []*Task[T]
+	for curr != nil {
+		task := tasks[curr.Label()]
+		chain = append(chain, task)
+
+		var next *gograph.Vertex[T]
+		for _, neighbor := range curr.Neighbors() {
+			successor := tasks[neighbor.Label()]
+			start := task.EarliestFinish + g.GetEdge(curr, neighbor).Weight()
+			if successor.Critical() && math.Abs(successor.EarliestStart-start) <= slackTolerance*math.Max(1, math.Abs(start)) {
+				next = neighbor
+				break
+			}
+		}
+
+		curr = next
+	}
+
+	return chain
+}
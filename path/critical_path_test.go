@@ -0,0 +1,95 @@
+package path
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func newProject(options ...gograph.GraphOptionFunc) gograph.Graph[string] {
+	g := gograph.New[string](append([]gograph.GraphOptionFunc{gograph.Acyclic()}, options...)...)
+
+	durations := map[string]float64{"A": 3, "B": 2, "C": 4, "D": 2, "E": 3}
+	for _, label := range []string{"A", "B", "C", "D", "E"} {
+		g.AddVertexByLabel(label, gograph.WithVertexWeight(durations[label]))
+	}
+
+	for _, e := range [][2]string{{"A", "C"}, {"A", "D"}, {"B", "D"}, {"C", "E"}, {"D", "E"}} {
+		_, _ = g.AddEdge(g.GetVertexByID(e[0]), g.GetVertexByID(e[1]))
+	}
+
+	return g
+}
+
+// chainLabels returns the labels of the critical chain.
+func chainLabels[T comparable](s *Schedule[T]) []T {
+	labels := make([]T, len(s.CriticalChain))
+	for i, task := range s.CriticalChain {
+		labels[i] = task.Label
+	}
+
+	return labels
+}
+
+func TestCriticalPath(t *testing.T) {
+	schedule, err := CriticalPath(newProject())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if schedule.Duration != 10 {
+		t.Errorf("expected duration 10, but got %v", schedule.Duration)
+	}
+
+	expected := map[string]Task[string]{
+		"A": {Label: "A", Duration: 3, EarliestStart: 0, EarliestFinish: 3, LatestStart: 0, LatestFinish: 3, Slack: 0},
+		"B": {Label: "B", Duration: 2, EarliestStart: 0, EarliestFinish: 2, LatestStart: 3, LatestFinish: 5, Slack: 3},
+		"C": {Label: "C", Duration: 4, EarliestStart: 3, EarliestFinish: 7, LatestStart: 3, LatestFinish: 7, Slack: 0},
+		"D": {Label: "D", Duration: 2, EarliestStart: 3, EarliestFinish: 5, LatestStart: 5, LatestFinish: 7, Slack: 2},
+		"E": {Label: "E", Duration: 3, EarliestStart: 7, EarliestFinish: 10, LatestStart: 7, LatestFinish: 10, Slack: 0},
+	}
+
+	for label, task := range expected {
+		if *schedule.Tasks[label] != task {
+			t.Errorf("expected task %+v, but got %+v", task, *schedule.Tasks[label])
+		}
+
+		if schedule.Tasks[label].Critical() != (task.Slack == 0) {
+			t.Errorf("expected task %s to be critical: %v", label, task.Slack == 0)
+		}
+	}
+
+	if chain := chainLabels(schedule); len(chain) != 3 || chain[0] != "A" || chain[1] != "C" || chain[2] != "E" {
+		t.Errorf("expected the critical chain A, C, E, but got %v", chain)
+	}
+}
+
+func TestCriticalPath_Lags(t *testing.T) {
+	g := newProject(gograph.Weighted())
+	g.RemoveEdges(g.GetEdge(g.GetVertexByID("D"), g.GetVertexByID("E")))
+	_, _ = g.AddEdge(g.GetVertexByID("D"), g.GetVertexByID("E"), gograph.WithEdgeWeight(3))
+
+	schedule, err := CriticalPath(g)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if schedule.Duration != 11 || schedule.Tasks["C"].Slack != 1 || schedule.Tasks["D"].Slack != 0 {
+		t.Errorf("expected duration 11, but got %v", schedule.Duration)
+	}
+
+	if chain := chainLabels(schedule); len(chain) != 3 || chain[0] != "A" || chain[1] != "D" || chain[2] != "E" {
+		t.Errorf("expected the critical chain A, D, E, but got %v", chain)
+	}
+
+	if schedule, _ = CriticalPath(gograph.New[string](gograph.Acyclic())); schedule.Duration != 0 || len(schedule.CriticalChain) != 0 {
+		t.Errorf("expected an empty schedule, but got %+v", schedule)
+	}
+
+	undirected := gograph.New[string]()
+	_, _ = undirected.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+	if _, err = CriticalPath(undirected); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("expected error %s, but got %v", ErrNotDirected, err)
+	}
+}
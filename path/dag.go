@@ -0,0 +1,109 @@
+package path
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// DAGShortestPaths finds the shortest paths from the source vertex to all
+// the reachable vertices of a directed acyclic graph, such as an Acyclic
+// graph. It relaxes the edges of each vertex in the topological order, so
+// the distance of a vertex is final before its edges are relaxed. It
+// takes O(V + E) time, and accepts negative edge weights, since a DAG has
+// no cycles.
+//
+// It returns gograph.ErrVertexDoesNotExist if the source vertex doesn't
+// exist in the graph, ErrNotDirected if the graph is undirected, and
+// gograph.ErrDAGHasCycle if the graph has a cycle.
+func DAGShortestPaths[T comparable](g gograph.Graph[T], source T) (*ShortestPaths[T], error) {
+	return dagPaths(g, source, func(alt, d float64) bool { return alt < d })
+}
+
+// DAGLongestPaths finds the longest paths from the source vertex to all
+// the reachable vertices of a directed acyclic graph, like
+// DAGShortestPaths, in O(V + E) time. The longest path problem is NP-hard
+// in general graphs, but in a DAG it is the shortest path problem with
+// the negated weights. The distances of the result are the costs of the
+// longest paths, and PathTo and Tree rebuild them.
+//
+// It returns the errors of DAGShortestPaths.
+func DAGLongestPaths[T comparable](g gograph.Graph[T], source T) (*ShortestPaths[T], error) {
+	return dagPaths(g, source, func(alt, d float64) bool { return alt > d })
+}
+
+// dagPaths relaxes the edges in the topological order, and keeps a new
+// distance if it is better than the old one.
+func dagPaths[T comparable](
+	g gograph.Graph[T],
+	source T,
+	better func(alt, d float64) bool,
+) (*ShortestPaths[T], error) {
+	if g.GetVertexByID(source) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	sorted, err := topologicalOrder(g)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := &ShortestPaths[T]{
+		graph:  g,
+		source: source,
+		dist:   map[T]float64{source: 0},
+		prev:   make(map[T]T),
+	}
+
+	for _, v := range sorted {
+		d, ok := paths.dist[v.Label()]
+		if !ok {
+			continue
+		}
+
+		for _, neighbor := range v.Neighbors() {
+			alt := d + g.GetEdge(v, neighbor).Weight()
+			if old, ok := paths.dist[neighbor.Label()]; !ok || better(alt, old) {
+				paths.dist[neighbor.Label()] = alt
+				paths.prev[neighbor.Label()] = v.Label()
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// topologicalOrder returns the vertices of the directed graph in a
+// topological order, with Kahn's algorithm. Unlike gograph.TopologySort,
+// it doesn't sort the vertices first, so it takes O(V + E) time, but the
+// order of the independent vertices may differ between the runs.
+//
+// It returns gograph.ErrDAGHasCycle if the graph has a cycle.
+func topologicalOrder[T comparable](g gograph.Graph[T]) ([]*gograph.Vertex[T], error) {
+	vertices := g.GetAllVertices()
+	inDegrees := make(map[T]int, len(vertices))
+	queue := make([]*gograph.Vertex[T], 0, len(vertices))
+	for _, v := range vertices {
+		inDegrees[v.Label()] = int(v.InDegree())
+		if v.InDegree() == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	for head := 0; head < len(queue); head++ {
+		for _, neighbor := range queue[head].Neighbors() {
+			inDegrees[neighbor.Label()]--
+			if inDegrees[neighbor.Label()] == 0 {
+				queue = append(queue, g.GetVertexByID(neighbor.Label()))
+			}
+		}
+	}
+
+	if len(queue) != len(vertices) {
+		return nil, gograph.ErrDAGHasCycle
+	}
+
+	return queue, nil
+}
@@ -0,0 +1,86 @@
+package path
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func newWeightedDAG() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Acyclic(), gograph.Weighted())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 3}, {"A", "C", 6}, {"B", "C", 4}, {"B", "D", 4},
+		{"B", "E", 11}, {"C", "D", 8}, {"D", "E", -4}, {"E", "F", 1},
+	}
+
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	g.AddVertexByLabel("G")
+	return g
+}
+
+func TestDAGShortestPaths(t *testing.T) {
+	g := newWeightedDAG()
+
+	paths, err := DAGShortestPaths(g, "A")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := map[string]float64{"A": 0, "B": 3, "C": 6, "D": 7, "E": 3, "F": 4}
+	if !reflect.DeepEqual(expected, paths.Distances()) {
+		t.Errorf("expected distances %v, but got %v", expected, paths.Distances())
+	}
+
+	p, _ := paths.PathTo("F")
+	if labels, _ := pathLabels(p); !reflect.DeepEqual([]string{"A", "B", "D", "E", "F"}, labels) || p.Cost != 4 {
+		t.Errorf("expected the path A, B, D, E, F with cost 4, but got %v with cost %v", labels, p.Cost)
+	}
+
+	if _, err = paths.PathTo("G"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = DAGShortestPaths(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestDAGLongestPaths(t *testing.T) {
+	g := newWeightedDAG()
+
+	paths, err := DAGLongestPaths(g, "A")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := map[string]float64{"A": 0, "B": 3, "C": 7, "D": 15, "E": 14, "F": 15}
+	if !reflect.DeepEqual(expected, paths.Distances()) {
+		t.Errorf("expected distances %v, but got %v", expected, paths.Distances())
+	}
+
+	p, _ := paths.PathTo("F")
+	if labels, _ := pathLabels(p); !reflect.DeepEqual([]string{"A", "B", "E", "F"}, labels) || p.Cost != 15 {
+		t.Errorf("expected the path A, B, E, F with cost 15, but got %v with cost %v", labels, p.Cost)
+	}
+
+	cyclic := gograph.New[string](gograph.Directed())
+	_, _ = cyclic.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+	_, _ = cyclic.AddEdge(cyclic.GetVertexByID("B"), cyclic.GetVertexByID("A"))
+	if _, err = DAGLongestPaths(cyclic, "A"); !errors.Is(err, gograph.ErrDAGHasCycle) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrDAGHasCycle, err)
+	}
+
+	undirected := gograph.New[string]()
+	_, _ = undirected.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"))
+	if _, err = DAGLongestPaths(undirected, "A"); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("expected error %s, but got %v", ErrNotDirected, err)
+	}
+}
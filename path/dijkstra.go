@@ -79,6 +79,51 @@ func Dijkstra[T comparable](g gograph.Graph[T], start T) map[T]float64 {
 		return make(map[T]float64)
 	}
 
+	// Return the distances from the start vertex to each other vertex
+	distances := make(map[T]float64)
+	for _, v := range dijkstra(g, start) {
+		distances[v.label] = v.dist
+	}
+
+	return distances
+}
+
+// DijkstraPaths runs Dijkstra's algorithm like Dijkstra, and returns the
+// shortest paths from the starting vertex, which also rebuild the paths
+// and the shortest-path tree.
+//
+// It returns gograph.ErrVertexDoesNotExist if the starting vertex doesn't
+// exist in the graph.
+func DijkstraPaths[T comparable](g gograph.Graph[T], start T) (*ShortestPaths[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	paths := &ShortestPaths[T]{
+		graph:  g,
+		source: start,
+		dist:   make(map[T]float64),
+		prev:   make(map[T]T),
+	}
+
+	for _, v := range dijkstra(g, start) {
+		if v.dist == math.MaxFloat64 {
+			continue
+		}
+
+		paths.dist[v.label] = v.dist
+		if v.label != start {
+			paths.prev[v.label] = v.prev
+		}
+	}
+
+	return paths, nil
+}
+
+// dijkstra runs Dijkstra's algorithm from the existing start vertex, and
+// returns the dijkstra vertices of the graph. The unreachable vertices
+// keep the distance math.MaxFloat64.
+func dijkstra[T comparable](g gograph.Graph[T], start T) map[T]*dVertex[T] {
 	// Initialize the heap and the visited map
 	pq := util.NewVertexPriorityQueue[T]()
 	visited := make(map[T]bool)
@@ -116,11 +161,5 @@ func Dijkstra[T comparable](g gograph.Graph[T], start T) map[T]float64 {
 		}
 	}
 
-	// Return the distances from the start vertex to each other vertex
-	distances := make(map[T]float64)
-	for _, v := range verticesMap {
-		distances[v.label] = v.dist
-	}
-
-	return distances
+	return verticesMap
 }
@@ -37,19 +37,44 @@ import (
 // graph has negative weight edges and no negative weight cycles, as it calculates
 // shortest paths between all pairs of vertices in one go.
 func FloydWarshall[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, error) {
+	dist, _, err := floydWarshall(g)
+	return dist, err
+}
+
+// FloydWarshallPaths runs the Floyd-Warshall algorithm like FloydWarshall,
+// and returns the shortest paths between all pairs of vertices. Besides
+// the distance matrix, it keeps the next-hop matrix, which rebuilds the
+// path between any pair of vertices in O(length) time.
+//
+// It returns the errors of FloydWarshall.
+func FloydWarshallPaths[T comparable](g gograph.Graph[T]) (*AllPairsShortestPaths[T], error) {
+	dist, next, err := floydWarshall(g)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllPairsShortestPaths[T]{graph: g, dist: dist, next: next}, nil
+}
+
+// floydWarshall returns the distance matrix and the next-hop matrix. The
+// next hop from a source to a target is the vertex after the source on
+// the shortest path, and there is none if the target is not reachable.
+func floydWarshall[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, map[T]map[T]T, error) {
 	if !g.IsWeighted() {
-		return nil, ErrNotWeighted
+		return nil, nil, ErrNotWeighted
 	}
 
 	if !g.IsDirected() {
-		return nil, ErrNotDirected
+		return nil, nil, ErrNotDirected
 	}
 
 	vertices := g.GetAllVertices()
 
 	dist := make(map[T]map[T]float64)
+	next := make(map[T]map[T]T)
 	maxValue := math.Inf(1)
 	for _, source := range vertices {
+		next[source.Label()] = make(map[T]T)
 		for _, dest := range vertices {
 			destMap, ok := dist[source.Label()]
 			if !ok {
@@ -59,10 +84,12 @@ func FloydWarshall[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, error
 			destMap[dest.Label()] = maxValue
 			if dest.Label() == source.Label() {
 				destMap[dest.Label()] = 0
+				next[source.Label()][dest.Label()] = dest.Label()
 			}
 
 			if edge := g.GetEdge(source, dest); edge != nil {
 				destMap[dest.Label()] = edge.Weight()
+				next[source.Label()][dest.Label()] = dest.Label()
 			}
 
 			dist[source.Label()] = destMap
@@ -75,6 +102,7 @@ func FloydWarshall[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, error
 				weight := dist[source.Label()][intermediate.Label()] + dist[intermediate.Label()][dest.Label()]
 				if weight < dist[source.Label()][dest.Label()] {
 					dist[source.Label()][dest.Label()] = weight
+					next[source.Label()][dest.Label()] = next[source.Label()][intermediate.Label()]
 				}
 			}
 		}
@@ -85,10 +113,10 @@ func FloydWarshall[T comparable](g gograph.Graph[T]) (map[T]map[T]float64, error
 		for _, edge := range edges {
 			if dist[v.Label()][edge.Source().Label()] != maxValue &&
 				dist[v.Label()][edge.Source().Label()]+edge.Weight() < dist[v.Label()][edge.Destination().Label()] {
-				return nil, ErrNegativeWeightCycle
+				return nil, nil, ErrNegativeWeightCycle
 			}
 		}
 	}
 
-	return dist, nil
+	return dist, next, nil
 }
@@ -0,0 +1,105 @@
+package path
+
+import (
+	"math"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371008.8
+
+// Coordinates is implemented by the vertex metadata that carries the
+// position of the vertex, which the coordinate heuristics read.
+type Coordinates interface {
+	// Coordinates returns the position, which is the x and y coordinates
+	// of a point, or the latitude and longitude in degrees of a location.
+	Coordinates() (float64, float64)
+}
+
+// Point is a position on a plane.
+type Point struct {
+	X, Y float64
+}
+
+// Coordinates returns the x and y coordinates of the point.
+func (p Point) Coordinates() (float64, float64) {
+	return p.X, p.Y
+}
+
+// LatLng is a position on the Earth, in degrees.
+type LatLng struct {
+	Lat, Lng float64
+}
+
+// Coordinates returns the latitude and longitude of the location.
+func (l LatLng) Coordinates() (float64, float64) {
+	return l.Lat, l.Lng
+}
+
+// EuclideanDistance is the straight-line distance between the positions
+// of the vertices. It is admissible if the weight of each edge is at least
+// the straight-line distance between its vertices.
+//
+// The positions are read from the vertex metadata, which must implement
+// Coordinates, such as Point. The distance is 0 if either vertex has no
+// position, which is still admissible.
+func EuclideanDistance[T comparable](v, goal *gograph.Vertex[T]) float64 {
+	x1, y1, x2, y2, ok := coordinates(v, goal)
+	if !ok {
+		return 0
+	}
+
+	return math.Hypot(x1-x2, y1-y2)
+}
+
+// ManhattanDistance is the distance between the positions of the vertices
+// along the axes. It is admissible on grids where the edges only move
+// along the axes, and the weight of each edge is at least its length.
+//
+// The positions are read like EuclideanDistance.
+func ManhattanDistance[T comparable](v, goal *gograph.Vertex[T]) float64 {
+	x1, y1, x2, y2, ok := coordinates(v, goal)
+	if !ok {
+		return 0
+	}
+
+	return math.Abs(x1-x2) + math.Abs(y1-y2)
+}
+
+// HaversineDistance is the great-circle distance in meters between the
+// locations of the vertices on the Earth. It is admissible on road
+// networks whose edge weights are lengths in meters.
+//
+// The locations are read from the vertex metadata, which must implement
+// Coordinates with the latitude and longitude in degrees, such as LatLng.
+// The distance is 0 if either vertex has no location.
+func HaversineDistance[T comparable](v, goal *gograph.Vertex[T]) float64 {
+	lat1, lng1, lat2, lng2, ok := coordinates(v, goal)
+	if !ok {
+		return 0
+	}
+
+	lat1, lng1 = lat1*math.Pi/180, lng1*math.Pi/180
+	lat2, lng2 = lat2*math.Pi/180, lng2*math.Pi/180
+
+	sinLat := math.Sin((lat2 - lat1) / 2)
+	sinLng := math.Sin((lng2 - lng1) / 2)
+	a := sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLng*sinLng
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// coordinates returns the positions of the vertices, and false if either
+// vertex metadata doesn't implement Coordinates.
+func coordinates[T comparable](v, goal *gograph.Vertex[T]) (float64, float64, float64, float64, bool) {
+	c1, ok1 := v.Metadata().(Coordinates)
+	c2, ok2 := goal.Metadata().(Coordinates)
+	if !ok1 || !ok2 {
+		return 0, 0, 0, 0, false
+	}
+
+	x1, y1 := c1.Coordinates()
+	x2, y2 := c2.Coordinates()
+	return x1, y1, x2, y2, true
+}
@@ -0,0 +1,47 @@
+package path
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestEuclideanDistance(t *testing.T) {
+	v := gograph.NewVertex("A", gograph.WithVertexMetadata(Point{X: 1, Y: 1}))
+	goal := gograph.NewVertex("B", gograph.WithVertexMetadata(&Point{X: 4, Y: 5}))
+
+	if d := EuclideanDistance(v, goal); d != 5 {
+		t.Errorf("expected distance 5, but got %v", d)
+	}
+
+	if d := EuclideanDistance(gograph.NewVertex("C"), goal); d != 0 {
+		t.Errorf("expected distance 0 without coordinates, but got %v", d)
+	}
+}
+
+func TestManhattanDistance(t *testing.T) {
+	v := gograph.NewVertex("A", gograph.WithVertexMetadata(Point{X: 1, Y: 1}))
+	goal := gograph.NewVertex("B", gograph.WithVertexMetadata(Point{X: -2, Y: 5}))
+
+	if d := ManhattanDistance(v, goal); d != 7 {
+		t.Errorf("expected distance 7, but got %v", d)
+	}
+
+	if d := ManhattanDistance(v, gograph.NewVertex("C", gograph.WithVertexMetadata("C"))); d != 0 {
+		t.Errorf("expected distance 0 without coordinates, but got %v", d)
+	}
+}
+
+func TestHaversineDistance(t *testing.T) {
+	paris := gograph.NewVertex("Paris", gograph.WithVertexMetadata(LatLng{Lat: 48.8566, Lng: 2.3522}))
+	london := gograph.NewVertex("London", gograph.WithVertexMetadata(LatLng{Lat: 51.5074, Lng: -0.1278}))
+
+	if d := HaversineDistance(paris, london); math.Abs(d-343556.5) > 1 {
+		t.Errorf("expected distance 343556.5 meters, but got %v", d)
+	}
+
+	if d := HaversineDistance(paris, paris); d != 0 {
+		t.Errorf("expected distance 0, but got %v", d)
+	}
+}
@@ -0,0 +1,103 @@
+package path
+
+import (
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// DijkstraImplicit finds a shortest path from the source vertex to the
+// closest vertex that satisfies the goal, in an implicit graph whose
+// edges are generated on demand. It only generates the successors of the
+// vertices that are closer than the goal, so the graph may be infinite.
+// A graph can be searched through gograph.AsImplicit.
+//
+// It returns the labels of the path from the source to the goal, and
+// the sum of its edge weights. It returns ErrNoPath if no goal is
+// reachable, and ErrNegativeWeight if it finds an edge with a negative
+// weight.
+func DijkstraImplicit[T comparable](
+	g gograph.Implicit[T],
+	source T,
+	goal func(label T) bool,
+) ([]T, float64, error) {
+	return bestFirst(g, source, goal, nil)
+}
+
+// AStarImplicit finds a shortest path from the source vertex to a vertex
+// that satisfies the goal, like DijkstraImplicit, and uses the heuristic
+// to expand the vertices that look closer to the goal first. The
+// heuristic estimates the remaining cost from a vertex to the goal. The
+// path is the shortest if the heuristic is admissible, i.e. it never
+// overestimates the remaining cost.
+//
+// It returns ErrNoPath if no goal is reachable, and ErrNegativeWeight if
+// it finds an edge with a negative weight.
+func AStarImplicit[T comparable](
+	g gograph.Implicit[T],
+	source T,
+	goal func(label T) bool,
+	heuristic func(label T) float64,
+) ([]T, float64, error) {
+	return bestFirst(g, source, goal, heuristic)
+}
+
+// bestFirst is the A* search, which is Dijkstra's algorithm without a
+// heuristic. The priority of a vertex is its distance from the source
+// plus its heuristic. Stale queue entries are skipped, and a vertex is
+// expanded again if a shorter path to it is found, so an admissible
+// heuristic that is not consistent still gives a shortest path.
+func bestFirst[T comparable](
+	g gograph.Implicit[T],
+	source T,
+	goal func(label T) bool,
+	heuristic func(label T) float64,
+) ([]T, float64, error) {
+	estimates := make(map[T]float64)
+	estimate := func(label T) float64 {
+		if heuristic == nil {
+			return 0
+		}
+
+		h, ok := estimates[label]
+		if !ok {
+			h = heuristic(label)
+			estimates[label] = h
+		}
+
+		return h
+	}
+
+	dist := map[T]float64{source: 0}
+	prev := make(map[T]T)
+
+	pq := util.NewVertexPriorityQueue[T]()
+	pq.Push(util.NewVertexWithPriority(gograph.NewVertex(source), estimate(source)))
+	for pq.Len() > 0 {
+		curr := pq.Pop()
+		label := curr.Vertex().Label()
+		if curr.Priority() > dist[label]+estimate(label) {
+			continue
+		}
+
+		if goal(label) {
+			return pathTo(prev, source, label), dist[label], nil
+		}
+
+		for _, successor := range g.Successors(label) {
+			if successor.Weight < 0 {
+				return nil, 0, ErrNegativeWeight
+			}
+
+			alt := dist[label] + successor.Weight
+			if d, ok := dist[successor.Label]; ok && alt >= d {
+				continue
+			}
+
+			dist[successor.Label] = alt
+			prev[successor.Label] = label
+			pq.Push(util.NewVertexWithPriority(gograph.NewVertex(successor.Label), alt+estimate(successor.Label)))
+		}
+	}
+
+	return nil, 0, ErrNoPath
+}
@@ -0,0 +1,94 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// newGridMap returns a 10x10 grid map, where the cells of the wall x = 5
+// are blocked, except the cell (5, 9).
+func newGridMap() gograph.Implicit[[2]int] {
+	return gograph.ImplicitFunc[[2]int](func(cell [2]int) []gograph.Successor[[2]int] {
+		var successors []gograph.Successor[[2]int]
+		for _, d := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			next := [2]int{cell[0] + d[0], cell[1] + d[1]}
+			if next[0] < 0 || next[0] > 9 || next[1] < 0 || next[1] > 9 || (next[0] == 5 && next[1] != 9) {
+				continue
+			}
+
+			successors = append(successors, gograph.Successor[[2]int]{Label: next, Weight: 1})
+		}
+
+		return successors
+	})
+}
+
+func TestDijkstraImplicit(t *testing.T) {
+	target := [2]int{9, 0}
+	path, cost, err := DijkstraImplicit(newGridMap(), [2]int{0, 0}, func(cell [2]int) bool { return cell == target })
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	// around the wall: 9 up, 9 right and 9 down.
+	if cost != 27 || len(path) != 28 || path[0] != [2]int{0, 0} || path[27] != target {
+		t.Errorf("expected a path of cost 27, but got %v with cost %v", path, cost)
+	}
+
+	_, _, err = DijkstraImplicit(newGridMap(), [2]int{0, 0}, func(cell [2]int) bool { return cell == [2]int{10, 10} })
+	if !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+}
+
+func TestDijkstraImplicit_Graph(t *testing.T) {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 4}, {"A", "C", 1}, {"C", "B", 2}, {"B", "D", 1}, {"C", "D", 5},
+	}
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	distances := Dijkstra(g, "A")
+	for label, expected := range distances {
+		_, cost, err := DijkstraImplicit(gograph.AsImplicit(g), "A", func(l string) bool { return l == label })
+		if err != nil || cost != expected {
+			t.Errorf("%s: expected cost %v, but got %v, %v", label, expected, cost, err)
+		}
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("D"), gograph.NewVertex("E"), gograph.WithEdgeWeight(-1))
+	_, _, err := DijkstraImplicit(gograph.AsImplicit(g), "A", func(l string) bool { return l == "E" })
+	if !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestAStarImplicit(t *testing.T) {
+	target := [2]int{9, 0}
+	manhattan := func(cell [2]int) float64 {
+		return math.Abs(float64(cell[0]-target[0])) + math.Abs(float64(cell[1]-target[1]))
+	}
+
+	path, cost, err := AStarImplicit(newGridMap(), [2]int{0, 0}, func(cell [2]int) bool { return cell == target }, manhattan)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if cost != 27 || len(path) != 28 {
+		t.Errorf("expected a path of cost 27, but got %v with cost %v", path, cost)
+	}
+
+	for i := 1; i < len(path); i++ {
+		if manhattan(path[i-1])-manhattan(path[i]) > 1 || path[i][0] == 5 && path[i][1] != 9 {
+			t.Errorf("expected a path of free adjacent cells, but got %v", path)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package path
+
+import (
+	"container/heap"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// JohnsonOptionFunc represent an alias of function type that modifies the
+// specified Johnson's algorithm options.
+type JohnsonOptionFunc func(options *JohnsonOptions)
+
+// JohnsonOptions represents the options of Johnson's algorithm.
+type JohnsonOptions struct {
+	workers int
+}
+
+// WithParallelism returns a JohnsonOptionFunc that sets the number of
+// goroutines that run the Dijkstra's searches, like the WithParallelism
+// of traverse. The default is runtime.GOMAXPROCS(0).
+func WithParallelism(workers int) JohnsonOptionFunc {
+	return func(options *JohnsonOptions) {
+		options.workers = workers
+	}
+}
+
+// Johnson finds the shortest paths between all pairs of vertices in a
+// weighted graph, even in the presence of negative weight edges, as long
+// as there are no negative weight cycles. It was published by Donald B.
+// Johnson in 1977.
+//
+// Steps:
+//
+//  1. Reweighting: Run the Bellman-Ford algorithm from a virtual vertex
+//     with a zero weight edge to every vertex, which gives a potential
+//     h(v) to each vertex. The new weight of each edge (u, v) is
+//     w(u, v) + h(u) - h(v), which is never negative, and changes the
+//     cost of every path from s to t by the same h(s) - h(t), so the
+//     shortest paths stay the same.
+//
+//  2. Dijkstra's Searches: Run Dijkstra's algorithm from each vertex on
+//     the reweighted graph. The searches are independent, so they run on
+//     a pool of goroutines.
+//
+//  3. Output: The distance from u to v is the reweighted distance minus
+//     h(u) - h(v).
+//
+// The time complexity of Johnson's algorithm is O(VE log V), which is
+// much faster than the O(V^3) of FloydWarshall on sparse graphs. It
+// returns the distances in the format of FloydWarshall, where the
+// unreachable vertices are at positive infinity, which takes O(V^2)
+// memory.
+//
+// It returns ErrNotWeighted if the graph is not weighted, ErrNotDirected
+// if the graph is not directed, and ErrNegativeWeightCycle if the graph
+// has a negative weight cycle.
+func Johnson[T comparable](g gograph.Graph[T], options ...JohnsonOptionFunc) (map[T]map[T]float64, error) {
+	if !g.IsWeighted() {
+		return nil, ErrNotWeighted
+	}
+
+	if !g.IsDirected() {
+		return nil, ErrNotDirected
+	}
+
+	opts := JohnsonOptions{workers: runtime.GOMAXPROCS(0)}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	j := newJohnson(g)
+	if !j.reweight() {
+		return nil, ErrNegativeWeightCycle
+	}
+
+	rows := make([]map[T]float64, len(j.labels))
+	var next int64 = -1
+
+	var wg sync.WaitGroup
+	for w := 0; w < max(1, min(opts.workers, len(j.labels))); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			search := newJohnsonSearch(len(j.labels))
+			for {
+				source := int(atomic.AddInt64(&next, 1))
+				if source >= len(j.labels) {
+					return
+				}
+
+				rows[source] = j.row(source, search)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	dist := make(map[T]map[T]float64, len(j.labels))
+	for i, row := range rows {
+		dist[j.labels[i]] = row
+	}
+
+	return dist, nil
+}
+
+// johnsonEdge is an edge to the vertex of the index to, with its weight.
+type johnsonEdge struct {
+	to     int
+	weight float64
+}
+
+// johnson is the indexed adjacency list of the graph, and the potentials
+// of its vertices.
+type johnson[T comparable] struct {
+	labels    []T             // the label of each vertex index.
+	out       [][]johnsonEdge // the outgoing edges of each vertex.
+	potential []float64       // the potential of each vertex, from the Bellman-Ford algorithm.
+}
+
+func newJohnson[T comparable](g gograph.Graph[T]) *johnson[T] {
+	vertices := g.GetAllVertices()
+	j := &johnson[T]{
+		labels:    make([]T, len(vertices)),
+		out:       make([][]johnsonEdge, len(vertices)),
+		potential: make([]float64, len(vertices)),
+	}
+
+	index := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		j.labels[i] = v.Label()
+		index[v.Label()] = i
+	}
+
+	for i, v := range vertices {
+		for _, neighbor := range v.Neighbors() {
+			j.out[i] = append(j.out[i], johnsonEdge{to: index[neighbor.Label()], weight: g.GetEdge(v, neighbor).Weight()})
+		}
+	}
+
+	return j
+}
+
+// reweight runs the Bellman-Ford algorithm from the virtual vertex, whose
+// zero weight edges start every potential at 0, and makes the weights
+// non-negative. It stops early when a round changes nothing, and returns
+// false if the V-th round still changes a potential, which means there
+// is a negative weight cycle.
+func (j *johnson[T]) reweight() bool {
+	for round := 0; ; round++ {
+		changed := false
+		for u, edges := range j.out {
+			for _, e := range edges {
+				if d := j.potential[u] + e.weight; d < j.potential[e.to] {
+					j.potential[e.to] = d
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		if round == len(j.labels)-1 {
+			return false
+		}
+	}
+
+	for u, edges := range j.out {
+		for i, e := range edges {
+			// the rounding errors must not make a weight negative.
+			edges[i].weight = math.Max(0, e.weight+j.potential[u]-j.potential[e.to])
+		}
+	}
+
+	return true
+}
+
+// row runs Dijkstra's algorithm from the source on the reweighted graph,
+// and returns the original distances from the source.
+func (j *johnson[T]) row(source int, s *johnsonSearch) map[T]float64 {
+	s.run(j.out, source)
+
+	row := make(map[T]float64, len(j.labels))
+	for v, d := range s.dist {
+		if !math.IsInf(d, 1) {
+			d = d - j.potential[source] + j.potential[v]
+		}
+
+		row[j.labels[v]] = d
+	}
+
+	return row
+}
+
+// johnsonSearch is the state of a Dijkstra's search, which a worker
+// reuses for all its sources.
+type johnsonSearch struct {
+	dist  []float64
+	queue johnsonQueue
+}
+
+func newJohnsonSearch(n int) *johnsonSearch {
+	return &johnsonSearch{dist: make([]float64, n)}
+}
+
+func (s *johnsonSearch) run(out [][]johnsonEdge, source int) {
+	for i := range s.dist {
+		s.dist[i] = math.Inf(1)
+	}
+
+	s.dist[source] = 0
+	s.queue = append(s.queue[:0], johnsonItem{vertex: source})
+	for len(s.queue) > 0 {
+		item := heap.Pop(&s.queue).(johnsonItem)
+		if item.dist > s.dist[item.vertex] {
+			continue
+		}
+
+		for _, e := range out[item.vertex] {
+			if d := item.dist + e.weight; d < s.dist[e.to] {
+				s.dist[e.to] = d
+				heap.Push(&s.queue, johnsonItem{vertex: e.to, dist: d})
+			}
+		}
+	}
+}
+
+// johnsonItem is a vertex index in the queue, with its tentative distance.
+type johnsonItem struct {
+	vertex int
+	dist   float64
+}
+
+// johnsonQueue is a min heap of vertex indices. The searches run on the
+// indexed adjacency lists, and each worker reuses its queue for all its
+// sources, so unlike util.VertexPriorityQueue, it doesn't allocate a
+// wrapper of a gograph.Vertex, or look up the labels, for every push.
+type johnsonQueue []johnsonItem
+
+func (q johnsonQueue) Len() int { return len(q) }
+
+func (q johnsonQueue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+
+func (q johnsonQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *johnsonQueue) Push(x any) { *q = append(*q, x.(johnsonItem)) }
+
+func (q *johnsonQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
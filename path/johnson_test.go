@@ -0,0 +1,99 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestJohnson(t *testing.T) {
+	g := newNegativeGraph()
+
+	dist, err := Johnson(g)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected, _ := FloydWarshall(g)
+	for source, destMap := range expected {
+		for dest, d := range destMap {
+			if dist[source][dest] != d {
+				t.Errorf("expected distance %v from %s to %s, but got %v", d, source, dest, dist[source][dest])
+			}
+		}
+	}
+
+	if len(dist) != len(expected) || len(dist["A"]) != len(expected["A"]) {
+		t.Errorf("expected %d rows, but got %d", len(expected), len(dist))
+	}
+
+	if !math.IsInf(dist["D"]["A"], 1) {
+		t.Errorf("expected A to be unreachable from D, but got %v", dist["D"]["A"])
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("D"), g.GetVertexByID("B"), gograph.WithEdgeWeight(-3))
+	if _, err = Johnson(g); !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeightCycle, err)
+	}
+
+	loop := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	_, _ = loop.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("A"), gograph.WithEdgeWeight(-1))
+	if _, err = Johnson(loop); !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeightCycle, err)
+	}
+
+	if _, err = Johnson(gograph.New[string](gograph.Directed())); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %s, but got %v", ErrNotWeighted, err)
+	}
+
+	if _, err = Johnson(gograph.New[string](gograph.Weighted())); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("expected error %s, but got %v", ErrNotDirected, err)
+	}
+
+	if dist, err = Johnson(gograph.New[string](gograph.Weighted(), gograph.Directed())); err != nil || len(dist) != 0 {
+		t.Errorf("expected no distances, but got %v, %v", dist, err)
+	}
+}
+
+func TestJohnson_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	for _, workers := range []int{1, 4} {
+		for range 10 {
+			g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+
+			// the weights w + p(u) - p(v) with w >= 0 make negative edges
+			// without negative cycles.
+			potential := make([]float64, 40)
+			for i := range potential {
+				g.AddVertexByLabel(i)
+				potential[i] = float64(rng.Intn(10))
+			}
+
+			for range 120 {
+				from, to := rng.Intn(40), rng.Intn(40)
+				if from != to {
+					weight := float64(rng.Intn(10)) + potential[from] - potential[to]
+					_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(weight))
+				}
+			}
+
+			expected, _ := FloydWarshall(g)
+			dist, err := Johnson(g, WithParallelism(workers))
+			if err != nil {
+				t.Fatalf("expected no error, but got %s", err)
+			}
+
+			for source, destMap := range expected {
+				for dest, d := range destMap {
+					if math.Abs(dist[source][dest]-d) > 1e-9 && !(math.IsInf(d, 1) && math.IsInf(dist[source][dest], 1)) {
+						t.Fatalf("expected distance %v from %d to %d, but got %v", d, source, dest, dist[source][dest])
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package path
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// PathIterator represents an iterator over the paths between two vertices.
+type PathIterator[T comparable] interface {
+	// HasNext returns a boolean value indicating whether there are more
+	// paths to be iterated over.
+	HasNext() bool
+
+	// Next returns the next path. If there are no more paths, it returns
+	// nil.
+	Next() *Path[T]
+
+	// Iterate iterates over all the paths and calls the provided callback
+	// function on each path. If the callback function returns an error,
+	// iteration is stopped and the error is returned.
+	Iterate(func(p *Path[T]) error) error
+
+	// Reset resets the iterator to its initial state, allowing the paths
+	// to be iterated over again from the beginning.
+	Reset()
+}
+
+// KShortestPaths returns the k shortest simple paths from the source
+// vertex to the target vertex in a weighted graph with non-negative edge
+// weights, in the order of their costs. The paths are simple, so they
+// don't visit a vertex twice. It returns fewer than k paths if there are
+// not as many simple paths.
+//
+// It returns the errors of NewKShortestPathIterator.
+func KShortestPaths[T comparable](g gograph.Graph[T], source, target T, k int) ([]*Path[T], error) {
+	iter, err := NewKShortestPathIterator(g, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]*Path[T], 0)
+	for len(paths) < k && iter.HasNext() {
+		paths = append(paths, iter.Next())
+	}
+
+	return paths, nil
+}
+
+// NewKShortestPathIterator creates an iterator over the simple paths from
+// the source vertex to the target vertex, in the order of their costs.
+// It uses Yen's algorithm, which computes each path when it is requested:
+// the k-th path takes O(V) Dijkstra's searches, one from each vertex of
+// the previous path. The paths of equal costs are in the order they are
+// found.
+//
+// The iterator reflects the graph at the time of each call, so the graph
+// must not change during the iteration.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, and ErrNegativeWeight if the graph has an edge with a
+// negative weight.
+func NewKShortestPathIterator[T comparable](g gograph.Graph[T], source, target T) (PathIterator[T], error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if err := checkNonNegative(g); err != nil {
+		return nil, err
+	}
+
+	y := &yenIterator[T]{graph: g, source: source, target: target}
+	y.Reset()
+	return y, nil
+}
+
+// yenEdge is an edge that a spur search must not follow.
+type yenEdge[T comparable] struct {
+	from, to T
+}
+
+// yenIterator implements the PathIterator interface with Yen's algorithm.
+type yenIterator[T comparable] struct {
+	graph      gograph.Graph[T]
+	source     T
+	target     T
+	found      [][]T      // the labels of the paths that are returned.
+	candidates []*Path[T] // the candidates for the next path, in the order of their costs.
+	next       *Path[T]   // the next path, if it is computed.
+	done       bool       // whether there are no more paths.
+}
+
+// HasNext returns a boolean indicating whether there are more paths. It
+// computes the next path, if it is not computed yet.
+func (y *yenIterator[T]) HasNext() bool {
+	if y.next == nil && !y.done {
+		y.next = y.advance()
+		y.done = y.next == nil
+	}
+
+	return y.next != nil
+}
+
+// Next returns the next shortest path.
+// If the HasNext is false, returns nil.
+func (y *yenIterator[T]) Next() *Path[T] {
+	if !y.HasNext() {
+		return nil
+	}
+
+	p := y.next
+	y.next = nil
+	return p
+}
+
+// Iterate iterates through all the remaining paths and applies the given
+// function to each path. If the function returns an error, the iteration
+// stops and the error is returned.
+func (y *yenIterator[T]) Iterate(f func(p *Path[T]) error) error {
+	for y.HasNext() {
+		if err := f(y.Next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset resets the iterator by setting the initial state of the iterator.
+func (y *yenIterator[T]) Reset() {
+	y.found = nil
+	y.candidates = nil
+	y.next = nil
+	y.done = false
+}
+
+// advance returns the next shortest path, or nil if there is none.
+func (y *yenIterator[T]) advance() *Path[T] {
+	if len(y.found) == 0 {
+		labels, cost := y.shortestPath(y.source, nil, nil)
+		if labels == nil {
+			return nil
+		}
+
+		y.found = append(y.found, labels)
+		return newPath(y.graph, labels, cost)
+	}
+
+	// each vertex of the previous path, except the target, is a spur
+	// vertex: the candidate follows the previous path to the spur vertex,
+	// and then deviates from all the paths found with the same root.
+	prev := y.found[len(y.found)-1]
+	rootCost := 0.0
+	for i := 0; i < len(prev)-1; i++ {
+		root := prev[:i+1]
+
+		removedEdges := make(map[yenEdge[T]]bool)
+		for _, labels := range y.found {
+			if len(labels) > i+1 && slices.Equal(labels[:i+1], root) {
+				removedEdges[yenEdge[T]{from: labels[i], to: labels[i+1]}] = true
+			}
+		}
+
+		removedVertices := make(map[T]bool, i)
+		for _, label := range root[:i] {
+			removedVertices[label] = true
+		}
+
+		spur, spurCost := y.shortestPath(prev[i], removedEdges, removedVertices)
+		if spur != nil {
+			labels := append(slices.Clone(root[:i]), spur...)
+			y.addCandidate(newPath(y.graph, labels, rootCost+spurCost))
+		}
+
+		rootCost += y.edgeWeight(prev[i], prev[i+1])
+	}
+
+	if len(y.candidates) == 0 {
+		return nil
+	}
+
+	p := y.candidates[0]
+	y.candidates = y.candidates[1:]
+	y.found = append(y.found, pathVertexLabels(p))
+	return p
+}
+
+// addCandidate inserts the path to the candidates after the candidates
+// of the same cost, unless it is already a candidate.
+func (y *yenIterator[T]) addCandidate(p *Path[T]) {
+	labels := pathVertexLabels(p)
+	for _, c := range y.candidates {
+		if slices.Equal(labels, pathVertexLabels(c)) {
+			return
+		}
+	}
+
+	i := sort.Search(len(y.candidates), func(i int) bool {
+		return y.candidates[i].Cost > p.Cost
+	})
+
+	y.candidates = slices.Insert(y.candidates, i, p)
+}
+
+// shortestPath runs Dijkstra's algorithm from the source to the target,
+// and doesn't follow the removed edges or enter the removed vertices. It
+// returns the labels of the path and its cost, or nil if the target is
+// not reachable.
+func (y *yenIterator[T]) shortestPath(source T, removedEdges map[yenEdge[T]]bool, removedVertices map[T]bool) ([]T, float64) {
+	search := newDijkstraSearch(y.graph.GetVertexByID(source), func(v *gograph.Vertex[T]) []*gograph.Edge[T] {
+		edges := make([]*gograph.Edge[T], 0, len(v.Neighbors()))
+		for _, neighbor := range v.Neighbors() {
+			if !removedVertices[neighbor.Label()] && !removedEdges[yenEdge[T]{from: v.Label(), to: neighbor.Label()}] {
+				edges = append(edges, y.graph.GetEdge(v, neighbor))
+			}
+		}
+
+		return edges
+	}, (*gograph.Edge[T]).Destination)
+
+	for !math.IsInf(search.top(), 1) {
+		if search.pq.Peek().Vertex().Label() == y.target {
+			return pathTo(search.prev, source, y.target), search.dist[y.target]
+		}
+
+		// the weights are checked by the constructor.
+		_, _ = search.settle()
+	}
+
+	return nil, 0
+}
+
+func (y *yenIterator[T]) edgeWeight(from, to T) float64 {
+	return y.graph.GetEdge(y.graph.GetVertexByID(from), y.graph.GetVertexByID(to)).Weight()
+}
+
+// pathVertexLabels returns the labels of the path vertices.
+func pathVertexLabels[T comparable](p *Path[T]) []T {
+	labels := make([]T, len(p.Vertices))
+	for i, v := range p.Vertices {
+		labels[i] = v.Label()
+	}
+
+	return labels
+}
+
+// checkNonNegative returns ErrNegativeWeight if the graph has an edge
+// with a negative weight.
+func checkNonNegative[T comparable](g gograph.Graph[T]) error {
+	for _, edge := range g.AllEdges() {
+		if edge.Weight() < 0 {
+			return ErrNegativeWeight
+		}
+	}
+
+	return nil
+}
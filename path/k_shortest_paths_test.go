@@ -0,0 +1,180 @@
+package path
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// newYenGraph returns the example graph of Yen's algorithm.
+func newYenGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	edges := []struct {
+		from, to string
+		weight   float64
+	}{
+		{"C", "D", 3}, {"C", "E", 2}, {"D", "F", 4}, {"E", "D", 1}, {"E", "F", 2},
+		{"E", "G", 3}, {"F", "G", 2}, {"F", "H", 1}, {"G", "H", 2},
+	}
+
+	for _, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e.from), gograph.NewVertex(e.to), gograph.WithEdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+// simplePathCosts returns the sorted costs of all the simple paths from
+// the source to the target.
+func simplePathCosts[T comparable](g gograph.Graph[T], source, target T) []float64 {
+	var costs []float64
+	onPath := make(map[T]bool)
+
+	var visit func(v *gograph.Vertex[T], cost float64)
+	visit = func(v *gograph.Vertex[T], cost float64) {
+		if v.Label() == target {
+			costs = append(costs, cost)
+			return
+		}
+
+		onPath[v.Label()] = true
+		for _, neighbor := range v.Neighbors() {
+			if !onPath[neighbor.Label()] {
+				visit(neighbor, cost+g.GetEdge(v, neighbor).Weight())
+			}
+		}
+
+		onPath[v.Label()] = false
+	}
+
+	visit(g.GetVertexByID(source), 0)
+	sort.Float64s(costs)
+	return costs
+}
+
+func TestKShortestPaths(t *testing.T) {
+	g := newYenGraph()
+
+	paths, err := KShortestPaths(g, "C", "H", 3)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if len(paths) != 3 {
+		t.Fatalf("expected 3 paths, but got %d", len(paths))
+	}
+
+	first, _ := pathLabels(paths[0])
+	second, _ := pathLabels(paths[1])
+	if !reflect.DeepEqual([]string{"C", "E", "F", "H"}, first) || paths[0].Cost != 5 ||
+		!reflect.DeepEqual([]string{"C", "E", "G", "H"}, second) || paths[1].Cost != 7 || paths[2].Cost != 8 {
+		t.Errorf("expected the paths C, E, F, H and C, E, G, H, but got %v and %v", first, second)
+	}
+
+	// there are 7 simple paths from C to H.
+	paths, _ = KShortestPaths(g, "C", "H", 10)
+	costs := make([]float64, len(paths))
+	for i, p := range paths {
+		costs[i] = p.Cost
+	}
+
+	if expected := simplePathCosts(g, "C", "H"); !reflect.DeepEqual(expected, costs) {
+		t.Errorf("expected costs %v, but got %v", expected, costs)
+	}
+
+	paths, _ = KShortestPaths(g, "H", "C", 3)
+	if len(paths) != 0 {
+		t.Errorf("expected no paths, but got %d", len(paths))
+	}
+
+	paths, _ = KShortestPaths(g, "C", "C", 3)
+	if len(paths) != 1 || len(paths[0].Vertices) != 1 {
+		t.Errorf("expected the path to the source to be the source, but got %v", paths)
+	}
+}
+
+func TestKShortestPaths_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+
+	for range 20 {
+		g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+		for i := range 9 {
+			g.AddVertexByLabel(i)
+		}
+
+		for range 25 {
+			from, to := rng.Intn(9), rng.Intn(9)
+			if from != to {
+				_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(rng.Intn(5))))
+			}
+		}
+
+		expected := simplePathCosts(g, 0, 8)
+		paths, _ := KShortestPaths(g, 0, 8, len(expected)+1)
+		if len(paths) != len(expected) {
+			t.Fatalf("expected %d paths, but got %d", len(expected), len(paths))
+		}
+
+		seen := make(map[string]bool)
+		for i, p := range paths {
+			labels, weights := pathLabels(p)
+			key := ""
+			visited := make(map[int]bool)
+			sum := 0.0
+			for j, label := range labels {
+				if visited[label] {
+					t.Fatalf("expected a simple path, but got %v", labels)
+				}
+
+				visited[label] = true
+				key += string(rune('0' + label))
+				if j < len(weights) {
+					sum += weights[j]
+				}
+			}
+
+			if seen[key] || p.Cost != expected[i] || sum != p.Cost {
+				t.Fatalf("expected a new path with cost %v, but got %v with cost %v", expected[i], labels, p.Cost)
+			}
+
+			seen[key] = true
+		}
+	}
+}
+
+func TestNewKShortestPathIterator(t *testing.T) {
+	g := newYenGraph()
+
+	iter, err := NewKShortestPathIterator(g, "C", "H")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	var costs []float64
+	_ = iter.Iterate(func(p *Path[string]) error {
+		costs = append(costs, p.Cost)
+		return nil
+	})
+
+	if len(costs) != 7 || iter.HasNext() || iter.Next() != nil {
+		t.Errorf("expected 7 paths, but got %v", costs)
+	}
+
+	iter.Reset()
+	if p := iter.Next(); p == nil || p.Cost != 5 {
+		t.Errorf("expected the shortest path after reset, but got %v", p)
+	}
+
+	if _, err = NewKShortestPathIterator(g, "C", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("H"), g.GetVertexByID("C"), gograph.WithEdgeWeight(-1))
+	if _, err = NewKShortestPathIterator(g, "C", "H"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
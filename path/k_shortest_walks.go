@@ -0,0 +1,305 @@
+package path
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// KShortestWalks returns the k shortest paths from the source vertex to
+// the target vertex in a weighted graph with non-negative edge weights,
+// in the order of their costs. Unlike KShortestPaths, the paths may visit
+// a vertex more than once, i.e. they may contain loops.
+//
+// It returns the errors of NewKShortestWalkIterator.
+func KShortestWalks[T comparable](g gograph.Graph[T], source, target T, k int) ([]*Path[T], error) {
+	iter, err := NewKShortestWalkIterator(g, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]*Path[T], 0)
+	for len(paths) < k && iter.HasNext() {
+		paths = append(paths, iter.Next())
+	}
+
+	return paths, nil
+}
+
+// NewKShortestWalkIterator creates an iterator over the paths from the
+// source vertex to the target vertex, which may contain loops, in the
+// order of their costs. If a cycle can reach the target, there are
+// infinitely many paths, and the iterator never ends.
+//
+// It follows Eppstein's algorithm. A single Dijkstra's search towards the
+// target gives the shortest-path tree to the target, and any other path
+// is the sequence of its sidetracks: the edges that leave the tree. A
+// sidetrack (u, v) makes the path longer by w(u, v) + d(v) - d(u), where
+// d is the distance to the target. The sequences of sidetracks form a
+// heap-ordered tree, where each sequence has at most two children, so
+// each path takes O(log k) time after the search, apart from building
+// the path itself. Eppstein's persistent heaps are replaced by a sorted
+// list of sidetracks of each tree path, which is built on the first use.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, and ErrNegativeWeight if the graph has an edge with a
+// negative weight.
+func NewKShortestWalkIterator[T comparable](g gograph.Graph[T], source, target T) (PathIterator[T], error) {
+	targetVertex := g.GetVertexByID(target)
+	if g.GetVertexByID(source) == nil || targetVertex == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if err := checkNonNegative(g); err != nil {
+		return nil, err
+	}
+
+	incoming := gograph.NewReverseIndex(g)
+	tree := newDijkstraSearch(targetVertex, func(v *gograph.Vertex[T]) []*gograph.Edge[T] {
+		return incoming.IncomingEdges(v.Label())
+	}, (*gograph.Edge[T]).Source)
+
+	for !math.IsInf(tree.top(), 1) {
+		_, _ = tree.settle()
+	}
+
+	e := &eppsteinIterator[T]{
+		graph:      g,
+		source:     source,
+		target:     target,
+		dist:       tree.dist,
+		next:       tree.prev,
+		sidetracks: make(map[T][]sidetrack[T]),
+	}
+
+	e.Reset()
+	return e, nil
+}
+
+// sidetrack is an edge that leaves the shortest-path tree, and the extra
+// cost of taking it.
+type sidetrack[T comparable] struct {
+	edge  *gograph.Edge[T]
+	delta float64
+}
+
+// walkState is a sequence of sidetracks. Its last sidetrack is the
+// index-th one of the list, which has the sidetracks of the tree path
+// from the head of the previous sidetrack.
+type walkState[T comparable] struct {
+	parent   *walkState[T] // the sequence without the last sidetrack.
+	list     []sidetrack[T]
+	index    int
+	cost     float64
+	sequence int // the push order, which breaks the ties of the costs.
+}
+
+// last returns the last sidetrack of the sequence.
+func (s *walkState[T]) last() sidetrack[T] {
+	return s.list[s.index]
+}
+
+// eppsteinIterator implements the PathIterator interface with the
+// Eppstein's sidetrack sequences.
+type eppsteinIterator[T comparable] struct {
+	graph      gograph.Graph[T]
+	source     T
+	target     T
+	dist       map[T]float64        // the distance of each vertex to the target.
+	next       map[T]T              // the next vertex towards the target in the shortest-path tree.
+	sidetracks map[T][]sidetrack[T] // the sorted sidetracks of the tree path from each vertex.
+	queue      walkQueue[T]         // the sequences of sidetracks, in the order of their costs.
+	pushed     int                  // the number of pushed sequences.
+	root       bool                 // whether the shortest path is not returned yet.
+}
+
+// HasNext returns a boolean indicating whether there are more paths.
+func (e *eppsteinIterator[T]) HasNext() bool {
+	return e.root || e.queue.Len() > 0
+}
+
+// Next returns the next shortest path, and pushes the children of its
+// sequence of sidetracks.
+// If the HasNext is false, returns nil.
+func (e *eppsteinIterator[T]) Next() *Path[T] {
+	if !e.HasNext() {
+		return nil
+	}
+
+	if e.root {
+		e.root = false
+		if list := e.sidetracksOf(e.source); len(list) > 0 {
+			e.push(nil, list, 0, e.dist[e.source]+list[0].delta)
+		}
+
+		return e.path(nil)
+	}
+
+	s := heap.Pop(&e.queue).(*walkState[T])
+
+	// the sibling replaces the last sidetrack with the next one.
+	if s.index+1 < len(s.list) {
+		e.push(s.parent, s.list, s.index+1, s.cost-s.last().delta+s.list[s.index+1].delta)
+	}
+
+	// the child appends the first sidetrack after the last one.
+	if list := e.sidetracksOf(s.last().edge.Destination().Label()); len(list) > 0 {
+		e.push(s, list, 0, s.cost+list[0].delta)
+	}
+
+	return e.path(s)
+}
+
+// Iterate iterates through all the remaining paths and applies the given
+// function to each path. If the function returns an error, the iteration
+// stops and the error is returned.
+func (e *eppsteinIterator[T]) Iterate(f func(p *Path[T]) error) error {
+	for e.HasNext() {
+		if err := f(e.Next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset resets the iterator by setting the initial state of the iterator.
+func (e *eppsteinIterator[T]) Reset() {
+	e.queue = nil
+	e.pushed = 0
+	_, e.root = e.dist[e.source]
+}
+
+func (e *eppsteinIterator[T]) push(parent *walkState[T], list []sidetrack[T], index int, cost float64) {
+	heap.Push(&e.queue, &walkState[T]{parent: parent, list: list, index: index, cost: cost, sequence: e.pushed})
+	e.pushed++
+}
+
+// sidetracksOf returns the sidetracks of the tree path from the vertex to
+// the target, in the order of their extra costs. The list of a vertex
+// merges its own sidetracks into the list of the next vertex, so the
+// lists of the tree path are built from the end.
+func (e *eppsteinIterator[T]) sidetracksOf(label T) []sidetrack[T] {
+	var pending []T
+	for curr := label; ; {
+		if _, ok := e.sidetracks[curr]; ok {
+			break
+		}
+
+		pending = append(pending, curr)
+		next, ok := e.next[curr]
+		if !ok {
+			break
+		}
+
+		curr = next
+	}
+
+	for i := len(pending) - 1; i >= 0; i-- {
+		var rest []sidetrack[T]
+		if next, ok := e.next[pending[i]]; ok {
+			rest = e.sidetracks[next]
+		}
+
+		e.sidetracks[pending[i]] = mergeSidetracks(e.ownSidetracks(pending[i]), rest)
+	}
+
+	return e.sidetracks[label]
+}
+
+// ownSidetracks returns the edges from the vertex that leave the tree and
+// can reach the target, in the order of their extra costs.
+func (e *eppsteinIterator[T]) ownSidetracks(label T) []sidetrack[T] {
+	v := e.graph.GetVertexByID(label)
+	next, hasNext := e.next[label]
+
+	var own []sidetrack[T]
+	for _, neighbor := range v.Neighbors() {
+		d, ok := e.dist[neighbor.Label()]
+		if !ok || (hasNext && neighbor.Label() == next) {
+			continue
+		}
+
+		edge := e.graph.GetEdge(v, neighbor)
+		own = append(own, sidetrack[T]{edge: edge, delta: edge.Weight() + d - e.dist[label]})
+	}
+
+	sort.SliceStable(own, func(i, j int) bool {
+		return own[i].delta < own[j].delta
+	})
+
+	return own
+}
+
+// mergeSidetracks merges the sorted lists, and prefers the sidetracks
+// of the first list on the ties, which leave the tree path earlier.
+func mergeSidetracks[T comparable](a, b []sidetrack[T]) []sidetrack[T] {
+	list := make([]sidetrack[T], 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if j == len(b) || (i < len(a) && a[i].delta <= b[j].delta) {
+			list = append(list, a[i])
+			i++
+		} else {
+			list = append(list, b[j])
+			j++
+		}
+	}
+
+	return list
+}
+
+// path follows the shortest-path tree from the source, and takes the
+// sidetracks of the sequence in order.
+func (e *eppsteinIterator[T]) path(s *walkState[T]) *Path[T] {
+	var edges []*gograph.Edge[T]
+	for ; s != nil; s = s.parent {
+		edges = append(edges, s.last().edge)
+	}
+
+	labels := []T{e.source}
+	curr := e.source
+	for i := len(edges) - 1; i >= 0; i-- {
+		for curr != edges[i].Source().Label() {
+			curr = e.next[curr]
+			labels = append(labels, curr)
+		}
+
+		curr = edges[i].Destination().Label()
+		labels = append(labels, curr)
+	}
+
+	for curr != e.target {
+		curr = e.next[curr]
+		labels = append(labels, curr)
+	}
+
+	return newWeightedPath(e.graph, labels)
+}
+
+// walkQueue is a min heap of sidetrack sequences.
+type walkQueue[T comparable] []*walkState[T]
+
+func (q walkQueue[T]) Len() int { return len(q) }
+
+func (q walkQueue[T]) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+
+	return q[i].sequence < q[j].sequence
+}
+
+func (q walkQueue[T]) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *walkQueue[T]) Push(x any) { *q = append(*q, x.(*walkState[T])) }
+
+func (q *walkQueue[T]) Pop() any {
+	old := *q
+	n := len(old)
+	s := old[n-1]
+	*q = old[:n-1]
+	return s
+}
@@ -0,0 +1,150 @@
+package path
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// walkCosts returns the sorted costs of all the paths from the source to
+// the target, which may contain loops, whose cost is at most the bound.
+// The edge weights must be positive.
+func walkCosts[T comparable](g gograph.Graph[T], source, target T, bound float64) []float64 {
+	var costs []float64
+
+	var visit func(v *gograph.Vertex[T], cost float64)
+	visit = func(v *gograph.Vertex[T], cost float64) {
+		if v.Label() == target {
+			costs = append(costs, cost)
+		}
+
+		for _, neighbor := range v.Neighbors() {
+			if next := cost + g.GetEdge(v, neighbor).Weight(); next <= bound {
+				visit(neighbor, next)
+			}
+		}
+	}
+
+	visit(g.GetVertexByID(source), 0)
+	sort.Float64s(costs)
+	return costs
+}
+
+func TestKShortestWalks(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	_, _ = g.AddEdge(gograph.NewVertex("A"), gograph.NewVertex("B"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("C"), gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(g.GetVertexByID("C"), g.GetVertexByID("B"), gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(g.GetVertexByID("A"), g.GetVertexByID("C"), gograph.WithEdgeWeight(3))
+
+	paths, err := KShortestWalks(g, "A", "C", 4)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := [][]string{
+		{"A", "B", "C"},
+		{"A", "C"},
+		{"A", "B", "C", "B", "C"},
+		{"A", "C", "B", "C"},
+	}
+	expectedCosts := []float64{2, 3, 5, 6}
+
+	for i, p := range paths {
+		labels, _ := pathLabels(p)
+		if !reflect.DeepEqual(expected[i], labels) || p.Cost != expectedCosts[i] {
+			t.Errorf("expected the path %v with cost %v, but got %v with cost %v", expected[i], expectedCosts[i], labels, p.Cost)
+		}
+	}
+
+	paths, _ = KShortestWalks(g, "C", "A", 4)
+	if len(paths) != 0 {
+		t.Errorf("expected no paths, but got %d", len(paths))
+	}
+
+	if _, err = KShortestWalks(g, "A", "X", 4); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("C"), g.GetVertexByID("A"), gograph.WithEdgeWeight(-1))
+	if _, err = KShortestWalks(g, "A", "C", 4); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestKShortestWalks_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+
+	for _, directed := range []bool{true, false} {
+		for range 10 {
+			options := []gograph.GraphOptionFunc{gograph.Weighted()}
+			if directed {
+				options = append(options, gograph.Directed())
+			}
+
+			g := gograph.New[int](options...)
+			for i := range 7 {
+				g.AddVertexByLabel(i)
+			}
+
+			for range 14 {
+				from, to := rng.Intn(7), rng.Intn(7)
+				if from != to {
+					_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(1+rng.Intn(4))))
+				}
+			}
+
+			const bound = 12
+			expected := walkCosts(g, 0, 6, bound)
+
+			iter, _ := NewKShortestWalkIterator(g, 0, 6)
+			var costs []float64
+			for iter.HasNext() {
+				p := iter.Next()
+				if p.Cost > bound {
+					break
+				}
+
+				if p.Vertices[0].Label() != 0 || p.Vertices[len(p.Vertices)-1].Label() != 6 {
+					t.Fatalf("expected a path from 0 to 6, but got %v", p.Vertices)
+				}
+
+				costs = append(costs, p.Cost)
+			}
+
+			if len(expected) != len(costs) || (len(costs) > 0 && !reflect.DeepEqual(expected, costs)) {
+				t.Fatalf("expected costs %v, but got %v", expected, costs)
+			}
+		}
+	}
+}
+
+func TestNewKShortestWalkIterator(t *testing.T) {
+	g := newYenGraph()
+
+	// a DAG has as many walks as simple paths.
+	iter, err := NewKShortestWalkIterator(g, "C", "H")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	var costs []float64
+	_ = iter.Iterate(func(p *Path[string]) error {
+		costs = append(costs, p.Cost)
+		return nil
+	})
+
+	if expected := simplePathCosts(g, "C", "H"); !reflect.DeepEqual(expected, costs) || iter.Next() != nil {
+		t.Errorf("expected costs %v, but got %v", expected, costs)
+	}
+
+	iter.Reset()
+	if p := iter.Next(); p == nil || p.Cost != 5 {
+		t.Errorf("expected the shortest path after reset, but got %v", p)
+	}
+}
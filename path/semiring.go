@@ -0,0 +1,131 @@
+package path
+
+import (
+	"math"
+)
+
+// Semiring represents a path algebra, which defines the value of a path
+// and how to choose between paths. The shortest path is the min-plus
+// semiring: the value of a path is the sum of its edge weights, and the
+// best of two paths is the one with the minimum value. Other semirings
+// give the widest, the most reliable, or the minimax paths with the same
+// algorithms.
+//
+// The semiring algorithms assume that the Combine function is selective,
+// i.e. it returns one of its arguments, which orders the values from the
+// best to the worst, and that Extend distributes over Combine.
+type Semiring[W comparable] interface {
+	// Zero returns the value of the vertices that are not reachable. It is
+	// the identity of Combine, and extending it by any value gives Zero.
+	Zero() W
+
+	// One returns the value of the empty path from a vertex to itself. It
+	// is the identity of Extend.
+	One() W
+
+	// Combine returns the better one of the values of two paths between
+	// the same vertices.
+	Combine(a, b W) W
+
+	// Extend returns the value of the path that follows a path of the
+	// value a by a path of the value b.
+	Extend(a, b W) W
+
+	// EdgeValue returns the value of the path with a single edge of the
+	// specified weight.
+	EdgeValue(weight float64) W
+}
+
+// MinPlus returns the semiring of the shortest paths, where the value of
+// a path is the sum of its edge weights, and the best path has the
+// minimum value.
+func MinPlus() Semiring[float64] {
+	return minPlus{}
+}
+
+// MaxMin returns the semiring of the widest (bottleneck) paths, where the
+// value of a path is the minimum weight of its edges, e.g. the bandwidth
+// of a network route, and the best path has the maximum value.
+func MaxMin() Semiring[float64] {
+	return maxMin{}
+}
+
+// MinMax returns the semiring of the minimax paths, where the value of a
+// path is the maximum weight of its edges, and the best path has the
+// minimum value.
+func MinMax() Semiring[float64] {
+	return minMax{}
+}
+
+// MaxTimes returns the semiring of the most reliable paths, where the
+// edge weights are probabilities, the value of a path is the product of
+// its edge weights, and the best path has the maximum value.
+func MaxTimes() Semiring[float64] {
+	return maxTimes{}
+}
+
+// Boolean returns the semiring of the reachability, where the value of a
+// vertex is true if it is reachable. It ignores the edge weights.
+func Boolean() Semiring[bool] {
+	return boolean{}
+}
+
+type minPlus struct{}
+
+func (minPlus) Zero() float64 { return math.Inf(1) }
+
+func (minPlus) One() float64 { return 0 }
+
+func (minPlus) Combine(a, b float64) float64 { return math.Min(a, b) }
+
+func (minPlus) Extend(a, b float64) float64 { return a + b }
+
+func (minPlus) EdgeValue(weight float64) float64 { return weight }
+
+type maxMin struct{}
+
+func (maxMin) Zero() float64 { return math.Inf(-1) }
+
+func (maxMin) One() float64 { return math.Inf(1) }
+
+func (maxMin) Combine(a, b float64) float64 { return math.Max(a, b) }
+
+func (maxMin) Extend(a, b float64) float64 { return math.Min(a, b) }
+
+func (maxMin) EdgeValue(weight float64) float64 { return weight }
+
+type minMax struct{}
+
+func (minMax) Zero() float64 { return math.Inf(1) }
+
+func (minMax) One() float64 { return math.Inf(-1) }
+
+func (minMax) Combine(a, b float64) float64 { return math.Min(a, b) }
+
+func (minMax) Extend(a, b float64) float64 { return math.Max(a, b) }
+
+func (minMax) EdgeValue(weight float64) float64 { return weight }
+
+type maxTimes struct{}
+
+func (maxTimes) Zero() float64 { return 0 }
+
+func (maxTimes) One() float64 { return 1 }
+
+func (maxTimes) Combine(a, b float64) float64 { return math.Max(a, b) }
+
+func (maxTimes) Extend(a, b float64) float64 { return a * b }
+
+func (maxTimes) EdgeValue(weight float64) float64 { return weight }
+
+type boolean struct{}
+
+func (boolean) Zero() bool { return false }
+
+func (boolean) One() bool { return true }
+
+func (boolean) Combine(a, b bool) bool { return a || b }
+
+func (boolean) Extend(a, b bool) bool { return a && b }
+
+func (boolean) EdgeValue(float64) bool { return true }
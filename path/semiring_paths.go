@@ -0,0 +1,424 @@
+package path
+
+import (
+	"container/heap"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// SemiringPaths is the result of a single-source semiring algorithm. It
+// keeps the value of the best path to each reachable vertex, and its
+// predecessor on the path, so the paths themselves can be rebuilt.
+type SemiringPaths[T comparable, W comparable] struct {
+	graph    gograph.Graph[T]
+	semiring Semiring[W]
+	source   T
+	value    map[T]W // the value of the best path to each reachable vertex.
+	prev     map[T]T // the predecessor of each reachable vertex, except the source.
+}
+
+// Source returns the label of the source vertex.
+func (s *SemiringPaths[T, W]) Source() T {
+	return s.source
+}
+
+// Value returns the value of the best path from the source to the target,
+// or the Zero of the semiring if the target is not reachable.
+func (s *SemiringPaths[T, W]) Value(target T) W {
+	if v, ok := s.value[target]; ok {
+		return v
+	}
+
+	return s.semiring.Zero()
+}
+
+// Values returns the values of the best paths from the source to all the
+// reachable vertices.
+func (s *SemiringPaths[T, W]) Values() map[T]W {
+	values := make(map[T]W, len(s.value))
+	for label, v := range s.value {
+		values[label] = v
+	}
+
+	return values
+}
+
+// HasPathTo returns true if the target is reachable from the source.
+func (s *SemiringPaths[T, W]) HasPathTo(target T) bool {
+	_, ok := s.value[target]
+	return ok
+}
+
+// Predecessor returns the vertex before the target on its best path. It
+// returns false if the target is the source or not reachable.
+func (s *SemiringPaths[T, W]) Predecessor(target T) (T, bool) {
+	label, ok := s.prev[target]
+	return label, ok
+}
+
+// PathTo returns the best path from the source to the target. The path to
+// the source itself has only the source vertex. The Cost of the path is
+// the sum of its edge weights, and Value gives its semiring value.
+//
+// It returns gograph.ErrVertexDoesNotExist if the target doesn't exist in
+// the graph, and ErrNoPath if it is not reachable.
+func (s *SemiringPaths[T, W]) PathTo(target T) (*Path[T], error) {
+	if s.graph.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if !s.HasPathTo(target) {
+		return nil, ErrNoPath
+	}
+
+	return newWeightedPath(s.graph, pathTo(s.prev, s.source, target)), nil
+}
+
+// SemiringAllPairs is the result of an all-pairs semiring algorithm. It
+// keeps the value of the best path between each pair of vertices, and the
+// next-hop matrix, so the paths between all the pairs can be rebuilt.
+type SemiringAllPairs[T comparable, W comparable] struct {
+	graph    gograph.Graph[T]
+	semiring Semiring[W]
+	value    map[T]map[T]W // the value of the best path from each source to each reachable target.
+	next     map[T]map[T]T // the next hop from each source to each reachable target.
+}
+
+// Value returns the value of the best path from the source to the target,
+// or the Zero of the semiring if the target is not reachable.
+func (a *SemiringAllPairs[T, W]) Value(source, target T) W {
+	if v, ok := a.value[source][target]; ok {
+		return v
+	}
+
+	return a.semiring.Zero()
+}
+
+// Values returns the values of the best paths from each source to each
+// reachable target.
+func (a *SemiringAllPairs[T, W]) Values() map[T]map[T]W {
+	values := make(map[T]map[T]W, len(a.value))
+	for source, destMap := range a.value {
+		values[source] = make(map[T]W, len(destMap))
+		for dest, v := range destMap {
+			values[source][dest] = v
+		}
+	}
+
+	return values
+}
+
+// NextHop returns the vertex after the source on the best path from the
+// source to the target. It returns false if the target is not reachable.
+// The next hop from a vertex to itself is the vertex.
+func (a *SemiringAllPairs[T, W]) NextHop(source, target T) (T, bool) {
+	label, ok := a.next[source][target]
+	return label, ok
+}
+
+// PathTo returns the best path from the source to the target. The Cost of
+// the path is the sum of its edge weights, and Value gives its semiring
+// value.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, and ErrNoPath if the target is not reachable.
+func (a *SemiringAllPairs[T, W]) PathTo(source, target T) (*Path[T], error) {
+	if a.graph.GetVertexByID(source) == nil || a.graph.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if _, ok := a.next[source][target]; !ok {
+		return nil, ErrNoPath
+	}
+
+	labels := []T{source}
+	for label := source; label != target; {
+		label = a.next[label][target]
+		labels = append(labels, label)
+	}
+
+	return newWeightedPath(a.graph, labels), nil
+}
+
+// SemiringDijkstra runs Dijkstra's algorithm over the semiring from the
+// source vertex, and returns the best paths to all the reachable
+// vertices. It settles the vertices from the best value to the worst,
+// which is only correct if extending a path never makes it better, i.e.
+// Combine(One, EdgeValue(w)) is One for every edge weight w: the weights
+// must be non-negative for MinPlus, and at most 1 for MaxTimes.
+//
+// The time complexity is O((V + E) log V), like Dijkstra's algorithm. The
+// paths of the Zero value are not paths, so the edges whose value is Zero
+// are not followed.
+//
+// It returns gograph.ErrVertexDoesNotExist if the source vertex doesn't
+// exist in the graph, and ErrNegativeWeight if an edge makes a path
+// better.
+func SemiringDijkstra[T comparable, W comparable](g gograph.Graph[T], source T, s Semiring[W]) (*SemiringPaths[T, W], error) {
+	if g.GetVertexByID(source) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	for _, edge := range g.AllEdges() {
+		if s.Combine(s.One(), s.EdgeValue(edge.Weight())) != s.One() {
+			return nil, ErrNegativeWeight
+		}
+	}
+
+	paths := newSemiringPaths(g, source, s)
+	settled := make(map[T]bool)
+	queue := &semiringQueue[T, W]{semiring: s}
+	heap.Push(queue, semiringItem[T, W]{label: source, value: s.One()})
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(semiringItem[T, W])
+		if settled[item.label] {
+			continue
+		}
+
+		settled[item.label] = true
+		v := g.GetVertexByID(item.label)
+		for _, neighbor := range v.Neighbors() {
+			if settled[neighbor.Label()] {
+				continue
+			}
+
+			value := s.Extend(item.value, s.EdgeValue(g.GetEdge(v, neighbor).Weight()))
+			if paths.relax(item.label, neighbor.Label(), value) {
+				heap.Push(queue, semiringItem[T, W]{label: neighbor.Label(), value: value})
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// SemiringBellmanFord runs the Bellman-Ford algorithm over the semiring
+// from the source vertex, and returns the best paths to all the reachable
+// vertices. Unlike SemiringDijkstra, an edge may make a path better, as
+// long as no cycle does, e.g. the negative weights of MinPlus, and the
+// relaxation stops early when an iteration doesn't change any value.
+//
+// The time complexity is O(V*E), like the Bellman-Ford algorithm. An
+// undirected edge that makes a path better is a cycle that makes it
+// better.
+//
+// It returns gograph.ErrVertexDoesNotExist if the source vertex doesn't
+// exist in the graph. If a cycle that makes the paths through it better
+// is reachable from the source, which is a negative weight cycle for
+// MinPlus, it returns a *NegativeCycleError with the vertices of the
+// cycle.
+func SemiringBellmanFord[T comparable, W comparable](g gograph.Graph[T], source T, s Semiring[W]) (*SemiringPaths[T, W], error) {
+	if g.GetVertexByID(source) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	paths := newSemiringPaths(g, source, s)
+	n := len(g.GetAllVertices())
+	edges := g.AllEdges()
+	for i := 1; ; i++ {
+		changed := false
+		for _, edge := range edges {
+			from, to := edge.Source().Label(), edge.Destination().Label()
+			if !paths.HasPathTo(from) {
+				continue
+			}
+
+			if !paths.relax(from, to, s.Extend(paths.value[from], s.EdgeValue(edge.Weight()))) {
+				continue
+			}
+
+			// a change in the V-th iteration means that the path has V
+			// edges, so it repeats a vertex.
+			if i == n {
+				return nil, &NegativeCycleError[T]{Cycle: predecessorCycle(paths.prev, to)}
+			}
+
+			changed = true
+		}
+
+		if !changed {
+			return paths, nil
+		}
+	}
+}
+
+// SemiringFloydWarshall runs the Floyd-Warshall algorithm over the
+// semiring, and returns the best paths between all pairs of vertices.
+// Like SemiringBellmanFord, an edge may make a path better, as long as no
+// cycle does.
+//
+// The time complexity is O(V^3), like the Floyd-Warshall algorithm.
+//
+// If there is a cycle that makes the paths through it better, which is a
+// negative weight cycle for MinPlus, it returns a *NegativeCycleError with
+// the vertices of the cycle.
+func SemiringFloydWarshall[T comparable, W comparable](g gograph.Graph[T], s Semiring[W]) (*SemiringAllPairs[T, W], error) {
+	vertices := g.GetAllVertices()
+	index := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		index[v.Label()] = i
+	}
+
+	// the next hop is -1 if the target is not reachable.
+	value := make([][]W, len(vertices))
+	next := make([][]int, len(vertices))
+	for i := range vertices {
+		value[i] = make([]W, len(vertices))
+		next[i] = make([]int, len(vertices))
+		for j := range vertices {
+			value[i][j] = s.Zero()
+			next[i][j] = -1
+		}
+
+		value[i][i] = s.One()
+		next[i][i] = i
+	}
+
+	for _, edge := range g.AllEdges() {
+		from, to := index[edge.Source().Label()], index[edge.Destination().Label()]
+		if v := s.Combine(value[from][to], s.EdgeValue(edge.Weight())); v != value[from][to] {
+			value[from][to] = v
+			next[from][to] = to
+		}
+	}
+
+	for k := range vertices {
+		for i := range vertices {
+			if next[i][k] == -1 {
+				continue
+			}
+
+			for j := range vertices {
+				if next[k][j] == -1 {
+					continue
+				}
+
+				if v := s.Combine(value[i][j], s.Extend(value[i][k], value[k][j])); v != value[i][j] {
+					value[i][j] = v
+					next[i][j] = next[i][k]
+				}
+			}
+		}
+	}
+
+	for i := range vertices {
+		if value[i][i] != s.One() {
+			return nil, semiringCycleError(g, s, vertices, next, i)
+		}
+	}
+
+	allPairs := &SemiringAllPairs[T, W]{
+		graph:    g,
+		semiring: s,
+		value:    make(map[T]map[T]W, len(vertices)),
+		next:     make(map[T]map[T]T, len(vertices)),
+	}
+
+	for i, source := range vertices {
+		allPairs.value[source.Label()] = make(map[T]W)
+		allPairs.next[source.Label()] = make(map[T]T)
+		for j, dest := range vertices {
+			if next[i][j] != -1 {
+				allPairs.value[source.Label()][dest.Label()] = value[i][j]
+				allPairs.next[source.Label()][dest.Label()] = vertices[next[i][j]].Label()
+			}
+		}
+	}
+
+	return allPairs, nil
+}
+
+// semiringCycleError returns the error of the cycle through the vertex i,
+// whose best path to itself is better than the empty path. It follows the
+// next hops from the vertex back to itself, and the first vertex that
+// repeats closes the cycle. If the cycle of the next hops doesn't make
+// the paths better, the Bellman-Ford algorithm from the vertex finds one
+// that does.
+func semiringCycleError[T comparable, W comparable](
+	g gograph.Graph[T],
+	s Semiring[W],
+	vertices []*gograph.Vertex[T],
+	next [][]int,
+	i int,
+) error {
+	position := make(map[int]int)
+	var walk []int
+	for curr := i; ; curr = next[curr][i] {
+		if pos, ok := position[curr]; ok {
+			walk = walk[pos:]
+			break
+		}
+
+		position[curr] = len(walk)
+		walk = append(walk, curr)
+	}
+
+	cycle := make([]T, len(walk))
+	value := s.One()
+	for j, curr := range walk {
+		after := walk[(j+1)%len(walk)]
+		cycle[j] = vertices[curr].Label()
+		value = s.Extend(value, s.EdgeValue(g.GetEdge(vertices[curr], vertices[after]).Weight()))
+	}
+
+	if s.Combine(s.One(), value) == s.One() {
+		_, err := SemiringBellmanFord(g, vertices[i].Label(), s)
+		return err
+	}
+
+	return &NegativeCycleError[T]{Cycle: cycle}
+}
+
+func newSemiringPaths[T comparable, W comparable](g gograph.Graph[T], source T, s Semiring[W]) *SemiringPaths[T, W] {
+	return &SemiringPaths[T, W]{
+		graph:    g,
+		semiring: s,
+		source:   source,
+		value:    map[T]W{source: s.One()},
+		prev:     make(map[T]T),
+	}
+}
+
+// relax combines the value of the path to the vertex with the value of
+// the path through the predecessor. It returns true if the value changes.
+func (s *SemiringPaths[T, W]) relax(prev, label T, value W) bool {
+	old := s.Value(label)
+	if s.semiring.Combine(old, value) == old {
+		return false
+	}
+
+	s.value[label] = value
+	s.prev[label] = prev
+	return true
+}
+
+// semiringItem is a vertex in the queue, with the value of its path.
+type semiringItem[T comparable, W comparable] struct {
+	label T
+	value W
+}
+
+// semiringQueue is a heap of vertices, where the top vertex has the best
+// value of the semiring.
+type semiringQueue[T comparable, W comparable] struct {
+	semiring Semiring[W]
+	items    []semiringItem[T, W]
+}
+
+func (q *semiringQueue[T, W]) Len() int { return len(q.items) }
+
+func (q *semiringQueue[T, W]) Less(i, j int) bool {
+	a, b := q.items[i].value, q.items[j].value
+	return a != b && q.semiring.Combine(a, b) == a
+}
+
+func (q *semiringQueue[T, W]) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *semiringQueue[T, W]) Push(x any) { q.items = append(q.items, x.(semiringItem[T, W])) }
+
+func (q *semiringQueue[T, W]) Pop() any {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items = q.items[:n-1]
+	return item
+}
@@ -0,0 +1,377 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// newBandwidthGraph returns a network whose edge weights are the
+// bandwidths of the links.
+func newBandwidthGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	g.AddVertexByLabel("F")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(10))
+	_, _ = g.AddEdge(vA, vC, gograph.WithEdgeWeight(3))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(8))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vD, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vD, vE, gograph.WithEdgeWeight(7))
+	_, _ = g.AddEdge(vC, vE, gograph.WithEdgeWeight(1))
+
+	return g
+}
+
+func TestSemiringDijkstra_Widest(t *testing.T) {
+	g := newBandwidthGraph()
+
+	paths, err := SemiringDijkstra(g, "A", MaxMin())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := map[string]float64{"A": math.Inf(1), "B": 10, "C": 8, "D": 5, "E": 5}
+	if !reflect.DeepEqual(expected, paths.Values()) {
+		t.Errorf("expected values %v, but got %v", expected, paths.Values())
+	}
+
+	p, err := paths.PathTo("E")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if labels, _ := pathLabels(p); !reflect.DeepEqual([]string{"A", "B", "C", "D", "E"}, labels) {
+		t.Errorf("expected the path A, B, C, D, E, but got %v", labels)
+	}
+
+	if p.Cost != 30 {
+		t.Errorf("expected the path cost %d, but got %f", 30, p.Cost)
+	}
+
+	if paths.HasPathTo("F") || paths.Value("F") != math.Inf(-1) {
+		t.Errorf("expected F to be unreachable, but got %f", paths.Value("F"))
+	}
+
+	if _, err = paths.PathTo("F"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = paths.PathTo("X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if prev, ok := paths.Predecessor("E"); !ok || prev != "D" {
+		t.Errorf("expected the predecessor of E to be D, but got %s", prev)
+	}
+
+	if paths.Source() != "A" {
+		t.Errorf("expected the source A, but got %s", paths.Source())
+	}
+}
+
+func TestSemiringDijkstra_MostReliable(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vS := g.AddVertexByLabel("S")
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vT := g.AddVertexByLabel("T")
+
+	_, _ = g.AddEdge(vS, vA, gograph.WithEdgeWeight(0.9))
+	_, _ = g.AddEdge(vA, vT, gograph.WithEdgeWeight(0.5))
+	_, _ = g.AddEdge(vS, vB, gograph.WithEdgeWeight(0.6))
+	_, _ = g.AddEdge(vB, vT, gograph.WithEdgeWeight(0.8))
+
+	paths, err := SemiringDijkstra(g, "S", MaxTimes())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if v := paths.Value("T"); math.Abs(v-0.48) > 1e-9 {
+		t.Errorf("expected the reliability %f, but got %f", 0.48, v)
+	}
+
+	p, _ := paths.PathTo("T")
+	if labels, _ := pathLabels(p); !reflect.DeepEqual([]string{"S", "B", "T"}, labels) {
+		t.Errorf("expected the path S, B, T, but got %v", labels)
+	}
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(1.5))
+	if _, err = SemiringDijkstra(g, "S", MaxTimes()); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestSemiringDijkstra_Minimax(t *testing.T) {
+	g := newBandwidthGraph()
+
+	paths, err := SemiringDijkstra(g, "A", MinMax())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	// the path A, C, E has the lowest maximum weight.
+	expected := map[string]float64{"A": math.Inf(-1), "B": 10, "C": 3, "D": 5, "E": 3}
+	if !reflect.DeepEqual(expected, paths.Values()) {
+		t.Errorf("expected values %v, but got %v", expected, paths.Values())
+	}
+}
+
+func TestSemiringDijkstra_Boolean(t *testing.T) {
+	g := newBandwidthGraph()
+
+	paths, err := SemiringDijkstra(g, "C", Boolean())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	expected := map[string]bool{"C": true, "D": true, "E": true}
+	if !reflect.DeepEqual(expected, paths.Values()) {
+		t.Errorf("expected values %v, but got %v", expected, paths.Values())
+	}
+
+	if paths.Value("A") {
+		t.Errorf("expected A to be unreachable")
+	}
+}
+
+func TestSemiringDijkstra_Errors(t *testing.T) {
+	g := newNegativeGraph()
+
+	if _, err := SemiringDijkstra(g, "X", MinPlus()); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err := SemiringDijkstra(g, "A", MinPlus()); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestSemiringBellmanFord(t *testing.T) {
+	g := newNegativeGraph()
+
+	expected, err := BellmanFordPaths(g, "A")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	paths, err := SemiringBellmanFord(g, "A", MinPlus())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(expected.Distances(), paths.Values()) {
+		t.Errorf("expected values %v, but got %v", expected.Distances(), paths.Values())
+	}
+
+	for label := range paths.Values() {
+		p, _ := paths.PathTo(label)
+		if p.Cost != paths.Value(label) {
+			t.Errorf("expected the path cost to %s to be %f, but got %f", label, paths.Value(label), p.Cost)
+		}
+	}
+
+	if _, err = SemiringBellmanFord(g, "X", MinPlus()); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestSemiringBellmanFord_NegativeCycle(t *testing.T) {
+	_, err := SemiringBellmanFord(newNegativeCycleGraph(), "A", MinPlus())
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"E", "D", "F"}, cycleErr.Cycle) {
+		t.Errorf("expected the cycle E, D, F, but got %v", err)
+	}
+
+	// a cycle whose product is more than 1 makes the paths more reliable.
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(0.5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vB, gograph.WithEdgeWeight(0.75))
+
+	_, err = SemiringBellmanFord(g, "A", MaxTimes())
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"B", "C"}, cycleErr.Cycle) {
+		t.Errorf("expected the cycle B, C, but got %v", err)
+	}
+}
+
+func TestSemiringFloydWarshall(t *testing.T) {
+	g := newNegativeGraph()
+
+	expected, err := FloydWarshallPaths(g)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	allPairs, err := SemiringFloydWarshall(g, MinPlus())
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	for _, source := range g.GetAllVertices() {
+		for _, dest := range g.GetAllVertices() {
+			if expected.Distance(source.Label(), dest.Label()) != allPairs.Value(source.Label(), dest.Label()) {
+				t.Errorf(
+					"expected the value from %s to %s to be %f, but got %f",
+					source.Label(),
+					dest.Label(),
+					expected.Distance(source.Label(), dest.Label()),
+					allPairs.Value(source.Label(), dest.Label()),
+				)
+			}
+
+			p, err := allPairs.PathTo(source.Label(), dest.Label())
+			if errors.Is(err, ErrNoPath) {
+				continue
+			}
+
+			if p.Cost != allPairs.Value(source.Label(), dest.Label()) {
+				t.Errorf("expected the path cost %f, but got %f", allPairs.Value(source.Label(), dest.Label()), p.Cost)
+			}
+		}
+	}
+
+	if _, err = allPairs.PathTo("A", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if next, ok := allPairs.NextHop("A", "A"); !ok || next != "A" {
+		t.Errorf("expected the next hop from A to itself to be A, but got %s", next)
+	}
+
+	_, err = SemiringFloydWarshall(newNegativeCycleGraph(), MinPlus())
+	if !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeightCycle, err)
+	}
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"E", "D", "F"}, cycleErr.Cycle) {
+		t.Errorf("expected the cycle E, D, F, but got %v", err)
+	}
+}
+
+func TestSemiringFloydWarshall_Cycle(t *testing.T) {
+	// a cycle whose product is more than 1 makes the paths more reliable.
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(0.5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vD, gograph.WithEdgeWeight(0.9))
+	_, _ = g.AddEdge(vD, vB, gograph.WithEdgeWeight(0.75))
+
+	_, err := SemiringFloydWarshall(g, MaxTimes())
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"B", "C", "D"}, cycleErr.Cycle) {
+		t.Errorf("expected the cycle B, C, D, but got %v", err)
+	}
+}
+
+func TestSemiring_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := range 20 {
+		options := []gograph.GraphOptionFunc{gograph.Weighted()}
+		if i%2 == 0 {
+			options = append(options, gograph.Directed())
+		}
+
+		g := gograph.New[int](options...)
+		for label := range 25 {
+			g.AddVertexByLabel(label)
+		}
+
+		for range 60 {
+			from, to := r.Intn(25), r.Intn(25)
+			if from != to {
+				_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(r.Intn(100)+1)/100))
+			}
+		}
+
+		for _, s := range []Semiring[float64]{MinPlus(), MaxMin(), MinMax(), MaxTimes()} {
+			allPairs, err := SemiringFloydWarshall(g, s)
+			if err != nil {
+				t.Fatalf("expected no error, but got %s", err)
+			}
+
+			for _, source := range []int{0, 7, 19} {
+				dijkstraPaths, err := SemiringDijkstra(g, source, s)
+				if err != nil {
+					t.Fatalf("expected no error, but got %s", err)
+				}
+
+				bellmanFordPaths, err := SemiringBellmanFord(g, source, s)
+				if err != nil {
+					t.Fatalf("expected no error, but got %s", err)
+				}
+
+				for label := range 25 {
+					d, b, f := dijkstraPaths.Value(label), bellmanFordPaths.Value(label), allPairs.Value(source, label)
+					if !closeTo(d, b) || !closeTo(d, f) {
+						t.Errorf("expected the same values from %d to %d, but got %f, %f and %f", source, label, d, b, f)
+					}
+				}
+			}
+		}
+	}
+}
+
+// closeTo returns true if the values are equal, apart from the rounding
+// errors.
+func closeTo(a, b float64) bool {
+	return a == b || math.Abs(a-b) <= 1e-9
+}
+
+func TestSemiringFloydWarshall_RandomCycles(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	for range 50 {
+		g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+		for label := range 12 {
+			g.AddVertexByLabel(label)
+		}
+
+		for range 30 {
+			from, to := r.Intn(12), r.Intn(12)
+			_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(r.Intn(14)-3)))
+		}
+
+		_, err := SemiringFloydWarshall(g, MinPlus())
+
+		var cycleErr *NegativeCycleError[int]
+		if !errors.As(err, &cycleErr) {
+			continue
+		}
+
+		sum := 0.0
+		for i, label := range cycleErr.Cycle {
+			next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+			edge := g.GetEdge(g.GetVertexByID(label), g.GetVertexByID(next))
+			if edge == nil {
+				t.Fatalf("expected the cycle edge %d -> %d", label, next)
+			}
+
+			sum += edge.Weight()
+		}
+
+		if sum >= 0 {
+			t.Errorf("expected the cycle %v to have a negative weight, but got %f", cycleErr.Cycle, sum)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package path
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSemiring_Float(t *testing.T) {
+	tests := []struct {
+		name     string
+		semiring Semiring[float64]
+		values   []float64
+	}{
+		{name: "MinPlus", semiring: MinPlus(), values: []float64{-2, 0, 1.5, 3}},
+		{name: "MaxMin", semiring: MaxMin(), values: []float64{-2, 0, 1.5, 3}},
+		{name: "MinMax", semiring: MinMax(), values: []float64{-2, 0, 1.5, 3}},
+		{name: "MaxTimes", semiring: MaxTimes(), values: []float64{0.1, 0.5, 0.75, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.semiring
+			values := append([]float64{s.Zero(), s.One()}, tt.values...)
+			for _, a := range values {
+				if s.Combine(a, s.Zero()) != a || s.Combine(s.Zero(), a) != a {
+					t.Errorf("expected Zero to be the identity of Combine, but got %f for %f", s.Combine(a, s.Zero()), a)
+				}
+
+				if s.Extend(a, s.One()) != a || s.Extend(s.One(), a) != a {
+					t.Errorf("expected One to be the identity of Extend, but got %f for %f", s.Extend(a, s.One()), a)
+				}
+
+				if s.Extend(a, s.Zero()) != s.Zero() {
+					t.Errorf("expected Zero to annihilate Extend, but got %f for %f", s.Extend(a, s.Zero()), a)
+				}
+
+				for _, b := range values {
+					if c := s.Combine(a, b); c != a && c != b {
+						t.Errorf("expected Combine to be selective, but got %f for %f and %f", c, a, b)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSemiring_Boolean(t *testing.T) {
+	s := Boolean()
+	if s.Zero() || !s.One() {
+		t.Errorf("expected Zero to be false and One to be true")
+	}
+
+	if !s.Combine(false, true) || s.Combine(false, false) {
+		t.Errorf("expected Combine to be the logical or")
+	}
+
+	if s.Extend(true, false) || !s.Extend(true, true) {
+		t.Errorf("expected Extend to be the logical and")
+	}
+
+	if !s.EdgeValue(math.Inf(-1)) {
+		t.Errorf("expected every edge to be true")
+	}
+}
@@ -0,0 +1,212 @@
+package path
+
+import (
+	"math"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// Path is a path of a graph, from its first vertex to its last one.
+type Path[T comparable] struct {
+	Vertices []*gograph.Vertex[T] // the vertices of the path, in order.
+	Edges    []*gograph.Edge[T]   // the edges between the consecutive vertices.
+	Cost     float64              // the sum of the edge weights.
+}
+
+// ShortestPaths is the result of a single-source shortest path algorithm.
+// Besides the distances, it keeps the predecessor of each reachable vertex
+// on its shortest path, so the paths themselves can be rebuilt.
+type ShortestPaths[T comparable] struct {
+	graph  gograph.Graph[T]
+	source T
+	dist   map[T]float64 // the distance of each reachable vertex.
+	prev   map[T]T       // the predecessor of each reachable vertex, except the source.
+}
+
+// Source returns the label of the source vertex.
+func (s *ShortestPaths[T]) Source() T {
+	return s.source
+}
+
+// Distance returns the shortest distance from the source to the target,
+// or positive infinity if the target is not reachable.
+func (s *ShortestPaths[T]) Distance(target T) float64 {
+	if d, ok := s.dist[target]; ok {
+		return d
+	}
+
+	return math.Inf(1)
+}
+
+// Distances returns the shortest distances from the source to all the
+// reachable vertices.
+func (s *ShortestPaths[T]) Distances() map[T]float64 {
+	distances := make(map[T]float64, len(s.dist))
+	for label, d := range s.dist {
+		distances[label] = d
+	}
+
+	return distances
+}
+
+// HasPathTo returns true if the target is reachable from the source.
+func (s *ShortestPaths[T]) HasPathTo(target T) bool {
+	_, ok := s.dist[target]
+	return ok
+}
+
+// Predecessor returns the vertex before the target on its shortest path.
+// It returns false if the target is the source or not reachable.
+func (s *ShortestPaths[T]) Predecessor(target T) (T, bool) {
+	label, ok := s.prev[target]
+	return label, ok
+}
+
+// PathTo returns the shortest path from the source to the target. The
+// path to the source itself has only the source vertex.
+//
+// It returns gograph.ErrVertexDoesNotExist if the target doesn't exist in
+// the graph, and ErrNoPath if it is not reachable.
+func (s *ShortestPaths[T]) PathTo(target T) (*Path[T], error) {
+	if s.graph.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if !s.HasPathTo(target) {
+		return nil, ErrNoPath
+	}
+
+	return newPath(s.graph, pathTo(s.prev, s.source, target), s.dist[target]), nil
+}
+
+// Tree returns the shortest-path tree as a new directed graph. It has the
+// reachable vertices, and an edge from the predecessor of each vertex to
+// the vertex, with the weight of the original edge.
+func (s *ShortestPaths[T]) Tree() gograph.Graph[T] {
+	options := []gograph.GraphOptionFunc{gograph.Directed()}
+	if s.graph.IsWeighted() {
+		options = append(options, gograph.Weighted())
+	}
+
+	tree := gograph.New[T](options...)
+	for _, v := range s.graph.GetAllVertices() {
+		if s.HasPathTo(v.Label()) {
+			tree.AddVertexByLabel(v.Label(), gograph.WithVertexWeight(v.Weight()), gograph.WithVertexMetadata(v.Metadata()))
+		}
+	}
+
+	for label, prev := range s.prev {
+		edge := s.graph.GetEdge(s.graph.GetVertexByID(prev), s.graph.GetVertexByID(label))
+		_, _ = tree.AddEdge(tree.GetVertexByID(prev), tree.GetVertexByID(label), gograph.WithEdgeWeight(edge.Weight()))
+	}
+
+	return tree
+}
+
+// AllPairsShortestPaths is the result of an all-pairs shortest path
+// algorithm. Besides the distances, it keeps the next-hop matrix: the
+// vertex after the source on the shortest path from each source to each
+// target, so the paths between all the pairs can be rebuilt.
+type AllPairsShortestPaths[T comparable] struct {
+	graph gograph.Graph[T]
+	dist  map[T]map[T]float64 // the distance from each source to each target.
+	next  map[T]map[T]T       // the next hop from each source to each reachable target.
+}
+
+// Distance returns the shortest distance from the source to the target,
+// or positive infinity if the target is not reachable.
+func (a *AllPairsShortestPaths[T]) Distance(source, target T) float64 {
+	if d, ok := a.dist[source][target]; ok {
+		return d
+	}
+
+	return math.Inf(1)
+}
+
+// Distances returns the distance matrix, in the format of FloydWarshall.
+func (a *AllPairsShortestPaths[T]) Distances() map[T]map[T]float64 {
+	distances := make(map[T]map[T]float64, len(a.dist))
+	for source, destMap := range a.dist {
+		distances[source] = make(map[T]float64, len(destMap))
+		for dest, d := range destMap {
+			distances[source][dest] = d
+		}
+	}
+
+	return distances
+}
+
+// NextHop returns the vertex after the source on the shortest path from
+// the source to the target. It returns false if the target is not
+// reachable. The next hop from a vertex to itself is the vertex.
+func (a *AllPairsShortestPaths[T]) NextHop(source, target T) (T, bool) {
+	label, ok := a.next[source][target]
+	return label, ok
+}
+
+// PathTo returns the shortest path from the source to the target.
+//
+// It returns gograph.ErrVertexDoesNotExist if either vertex doesn't exist
+// in the graph, and ErrNoPath if the target is not reachable.
+func (a *AllPairsShortestPaths[T]) PathTo(source, target T) (*Path[T], error) {
+	if a.graph.GetVertexByID(source) == nil || a.graph.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if _, ok := a.next[source][target]; !ok {
+		return nil, ErrNoPath
+	}
+
+	labels := []T{source}
+	for label := source; label != target; {
+		label = a.next[label][target]
+		labels = append(labels, label)
+	}
+
+	return newPath(a.graph, labels, a.dist[source][target]), nil
+}
+
+// newPath returns the path of the graph through the specified labels.
+func newPath[T comparable](g gograph.Graph[T], labels []T, cost float64) *Path[T] {
+	p := &Path[T]{
+		Vertices: make([]*gograph.Vertex[T], len(labels)),
+		Edges:    make([]*gograph.Edge[T], 0, len(labels)-1),
+		Cost:     cost,
+	}
+
+	for i, label := range labels {
+		p.Vertices[i] = g.GetVertexByID(label)
+		if i > 0 {
+			p.Edges = append(p.Edges, g.GetEdge(p.Vertices[i-1], p.Vertices[i]))
+		}
+	}
+
+	return p
+}
+
+// newWeightedPath returns the path of the graph through the specified
+// labels, whose cost is the sum of its edge weights.
+func newWeightedPath[T comparable](g gograph.Graph[T], labels []T) *Path[T] {
+	p := newPath(g, labels, 0)
+	for _, edge := range p.Edges {
+		p.Cost += edge.Weight()
+	}
+
+	return p
+}
+
+// pathTo follows the previous vertices from the target back to the
+// source, and returns the path from the source to the target.
+func pathTo[T comparable](prev map[T]T, source, target T) []T {
+	path := []T{target}
+	for label := target; label != source; {
+		label = prev[label]
+		path = append(path, label)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
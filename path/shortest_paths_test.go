@@ -0,0 +1,210 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// pathLabels returns the labels of the path vertices, and the weights of
+// its edges.
+func pathLabels[T comparable](p *Path[T]) ([]T, []float64) {
+	labels := make([]T, len(p.Vertices))
+	for i, v := range p.Vertices {
+		labels[i] = v.Label()
+	}
+
+	weights := make([]float64, len(p.Edges))
+	for i, e := range p.Edges {
+		weights[i] = e.Weight()
+	}
+
+	return labels, weights
+}
+
+func newNegativeGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	vF := g.AddVertexByLabel("F")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vE, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vE, vD, gograph.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(vD, vF, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vF, vE, gograph.WithEdgeWeight(3))
+
+	return g
+}
+
+func TestDijkstraPaths(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vB, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vD, gograph.WithEdgeWeight(5))
+
+	if _, err := DijkstraPaths(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	paths, err := DijkstraPaths(g, "A")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	p, err := paths.PathTo("D")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	labels, weights := pathLabels(p)
+	if !reflect.DeepEqual([]string{"A", "C", "B", "D"}, labels) ||
+		!reflect.DeepEqual([]float64{1, 2, 1}, weights) || p.Cost != 4 {
+		t.Errorf("expected the path A, C, B, D with cost 4, but got %v %v with cost %v", labels, weights, p.Cost)
+	}
+
+	if prev, ok := paths.Predecessor("B"); !ok || prev != "C" {
+		t.Errorf("expected predecessor C, but got %v", prev)
+	}
+
+	if _, ok := paths.Predecessor("A"); ok {
+		t.Errorf("expected the source to have no predecessor")
+	}
+
+	p, _ = paths.PathTo("A")
+	if len(p.Vertices) != 1 || len(p.Edges) != 0 || p.Cost != 0 {
+		t.Errorf("expected the path to the source to be the source, but got %v", p)
+	}
+
+	if _, err = paths.PathTo("E"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = paths.PathTo("X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if !math.IsInf(paths.Distance("E"), 1) || paths.HasPathTo("E") {
+		t.Errorf("expected E to be unreachable, but got distance %v", paths.Distance("E"))
+	}
+
+	expectedDist := map[string]float64{"A": 0, "B": 3, "C": 1, "D": 4}
+	if !reflect.DeepEqual(expectedDist, paths.Distances()) {
+		t.Errorf("expected distances %v, but got %v", expectedDist, paths.Distances())
+	}
+
+	tree := paths.Tree()
+	if !tree.IsDirected() || !tree.IsWeighted() || tree.Order() != 4 || tree.Size() != 3 {
+		t.Fatalf("expected a directed weighted tree with 4 vertices and 3 edges, but got %d and %d",
+			tree.Order(), tree.Size())
+	}
+
+	for _, edge := range [][2]string{{"A", "C"}, {"C", "B"}, {"B", "D"}} {
+		if e := tree.GetEdge(tree.GetVertexByID(edge[0]), tree.GetVertexByID(edge[1])); e == nil {
+			t.Errorf("expected the tree edge %s -> %s", edge[0], edge[1])
+		}
+	}
+}
+
+func TestBellmanFordPaths(t *testing.T) {
+	g := newNegativeGraph()
+
+	if _, err := BellmanFordPaths(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	paths, err := BellmanFordPaths(g, "B")
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	p, _ := paths.PathTo("F")
+	labels, _ := pathLabels(p)
+	if !reflect.DeepEqual([]string{"B", "C", "E", "D", "F"}, labels) || p.Cost != 3 {
+		t.Errorf("expected the path B, C, E, D, F with cost 3, but got %v with cost %v", labels, p.Cost)
+	}
+
+	if _, err = paths.PathTo("A"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected error %s, but got %v", ErrNoPath, err)
+	}
+
+	if tree := paths.Tree(); tree.Order() != 5 || tree.Size() != 4 {
+		t.Errorf("expected a tree with 5 vertices and 4 edges, but got %d and %d", tree.Order(), tree.Size())
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID("D"), g.GetVertexByID("B"), gograph.WithEdgeWeight(-3))
+	if _, err = BellmanFordPaths(g, "A"); !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("expected error %s, but got %v", ErrNegativeWeightCycle, err)
+	}
+}
+
+func TestFloydWarshallPaths(t *testing.T) {
+	g := newNegativeGraph()
+
+	paths, err := FloydWarshallPaths(g)
+	if err != nil {
+		t.Fatalf("expected no error, but got %s", err)
+	}
+
+	dist, _ := FloydWarshall(g)
+	if !reflect.DeepEqual(dist, paths.Distances()) {
+		t.Errorf("expected distances %v, but got %v", dist, paths.Distances())
+	}
+
+	for source, destMap := range dist {
+		for dest, d := range destMap {
+			p, err := paths.PathTo(source, dest)
+			if math.IsInf(d, 1) {
+				if !errors.Is(err, ErrNoPath) {
+					t.Errorf("%s -> %s: expected error %s, but got %v", source, dest, ErrNoPath, err)
+				}
+
+				continue
+			}
+
+			_, weights := pathLabels(p)
+			sum := 0.0
+			for _, w := range weights {
+				sum += w
+			}
+
+			if p.Cost != d || sum != d || p.Vertices[0].Label() != source || p.Vertices[len(p.Vertices)-1].Label() != dest {
+				t.Errorf("%s -> %s: expected a path with cost %v, but got %v", source, dest, d, p)
+			}
+		}
+	}
+
+	if next, ok := paths.NextHop("A", "D"); !ok || next != "B" {
+		t.Errorf("expected next hop B, but got %v", next)
+	}
+
+	if _, ok := paths.NextHop("D", "A"); ok {
+		t.Errorf("expected no next hop from D to A")
+	}
+
+	if _, err = paths.PathTo("A", "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err = FloydWarshallPaths(gograph.New[string](gograph.Directed())); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("expected error %s, but got %v", ErrNotWeighted, err)
+	}
+}
@@ -0,0 +1,102 @@
+package path
+
+import (
+	"math"
+	"slices"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// SPFA (Shortest Path Faster Algorithm) is the queue-based variant of the
+// Bellman-Ford algorithm. Instead of relaxing all the edges in each
+// iteration, it keeps a queue of the vertices whose distance was reduced,
+// and only relaxes their edges. It terminates as soon as the queue is
+// empty, which is much faster than the Bellman-Ford algorithm on most
+// graphs, but the worst case time complexity is still O(V*E).
+//
+// It counts the edges of the shortest path to each vertex. A path with V
+// edges repeats a vertex, which means there is a negative weight cycle.
+//
+// It returns the distances in the format of BellmanFord, and the errors
+// of BellmanFord. It also returns gograph.ErrVertexDoesNotExist if the
+// start vertex doesn't exist in the graph.
+func SPFA[T comparable](g gograph.Graph[T], start T) (map[T]float64, error) {
+	if err := validateBellmanFord(g); err != nil {
+		return nil, err
+	}
+
+	startVertex := g.GetVertexByID(start)
+	if startVertex == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	vertices := g.GetAllVertices()
+	dist := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		dist[v.Label()] = math.Inf(1)
+	}
+
+	dist[start] = 0
+	prev := make(map[T]T)
+	length := make(map[T]int)
+	inQueue := map[T]bool{start: true}
+	queue := []*gograph.Vertex[T]{startVertex}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		inQueue[u.Label()] = false
+
+		for _, v := range u.Neighbors() {
+			alt := dist[u.Label()] + g.GetEdge(u, v).Weight()
+			if alt >= dist[v.Label()] {
+				continue
+			}
+
+			dist[v.Label()] = alt
+			prev[v.Label()] = u.Label()
+			length[v.Label()] = length[u.Label()] + 1
+			if length[v.Label()] >= len(vertices) {
+				if cycle := predecessorCycle(prev, v.Label()); cycle != nil {
+					return nil, &NegativeCycleError[T]{Cycle: cycle}
+				}
+
+				// the predecessors have changed since the lengths were
+				// counted, so the Bellman-Ford algorithm finds the cycle.
+				_, _, err := bellmanFord(g, start)
+				return nil, err
+			}
+
+			if !inQueue[v.Label()] {
+				inQueue[v.Label()] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return dist, nil
+}
+
+// predecessorCycle follows the predecessors from the vertex, and returns
+// the cycle that they lead to, or nil if they lead to the start vertex.
+// After the V-th relaxation of the Bellman-Ford algorithm, the
+// predecessors of the relaxed vertex always lead to a negative weight
+// cycle.
+func predecessorCycle[T comparable](prev map[T]T, label T) []T {
+	visited := make(map[T]bool)
+	for !visited[label] {
+		visited[label] = true
+
+		var ok bool
+		if label, ok = prev[label]; !ok {
+			return nil
+		}
+	}
+
+	cycle := []T{label}
+	for v := prev[label]; v != label; v = prev[v] {
+		cycle = append(cycle, v)
+	}
+
+	slices.Reverse(cycle)
+	return cycle
+}
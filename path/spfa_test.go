@@ -0,0 +1,164 @@
+package path
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestSPFA(t *testing.T) {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	vF := g.AddVertexByLabel("F")
+	g.AddVertexByLabel("G")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vE, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vE, vD, gograph.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(vD, vF, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vF, vE, gograph.WithEdgeWeight(3))
+
+	dist, err := SPFA(g, vA.Label())
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	expected := map[string]float64{"A": 0, "B": 5, "C": 6, "D": 6, "E": 7, "F": 8, "G": math.Inf(1)}
+	for label, d := range expected {
+		if dist[label] != d {
+			t.Errorf("Expected A to %s shortest distance to be %f, but got %f", label, d, dist[label])
+		}
+	}
+}
+
+func TestSPFA_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for range 20 {
+		g := gograph.New[int](gograph.Weighted(), gograph.Directed())
+		for i := range 30 {
+			g.AddVertexByLabel(i)
+		}
+
+		// the edges go from a lower label to a higher one, so the negative
+		// weights don't make a cycle.
+		for range 120 {
+			from, to := r.Intn(30), r.Intn(30)
+			if from >= to {
+				continue
+			}
+
+			_, _ = g.AddEdge(g.GetVertexByID(from), g.GetVertexByID(to), gograph.WithEdgeWeight(float64(r.Intn(20)-5)))
+		}
+
+		expected, err := BellmanFord(g, 0)
+		if err != nil {
+			t.Fatalf("Expected no errors, but get an err: %s", err)
+		}
+
+		dist, err := SPFA(g, 0)
+		if err != nil {
+			t.Fatalf("Expected no errors, but get an err: %s", err)
+		}
+
+		for label, d := range expected {
+			if dist[label] != d {
+				t.Errorf("Expected 0 to %d shortest distance to be %f, but got %f", label, d, dist[label])
+			}
+		}
+	}
+}
+
+func TestSPFA_NegativeCycle(t *testing.T) {
+	g := newNegativeCycleGraph()
+
+	_, err := SPFA(g, "A")
+	if !errors.Is(err, ErrNegativeWeightCycle) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrNegativeWeightCycle, err)
+	}
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !isRotation([]string{"E", "D", "F"}, cycleErr.Cycle) {
+		t.Errorf("Expected the cycle E, D, F, but got %v", err)
+	}
+}
+
+func TestSPFA_UnreachableNegativeCycle(t *testing.T) {
+	g := newNegativeCycleGraph()
+
+	g.AddVertexByLabel("G")
+
+	dist, err := SPFA(g, "G")
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	if dist["G"] != 0 || !math.IsInf(dist["A"], 1) {
+		t.Errorf("Expected only G to be reachable, but got %v", dist)
+	}
+}
+
+func TestSPFA_Errors(t *testing.T) {
+	unweighted := gograph.New[string](gograph.Directed())
+	unweighted.AddVertexByLabel("A")
+	if _, err := SPFA(unweighted, "A"); !errors.Is(err, ErrNotWeighted) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrNotWeighted, err)
+	}
+
+	undirected := gograph.New[string](gograph.Weighted())
+	vA := undirected.AddVertexByLabel("A")
+	vB := undirected.AddVertexByLabel("B")
+	vC := undirected.AddVertexByLabel("C")
+	_, _ = undirected.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = undirected.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
+
+	dist, err := SPFA(undirected, "C")
+	if err != nil {
+		t.Fatalf("Expected no errors, but get an err: %s", err)
+	}
+
+	if dist["A"] != 6 {
+		t.Errorf("Expected C to A shortest distance to be %d, but got %f", 6, dist["A"])
+	}
+
+	if _, err = SPFA(undirected, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	_, _ = undirected.AddEdge(vA, vC, gograph.WithEdgeWeight(-1))
+	if _, err = SPFA(undirected, "A"); !errors.Is(err, ErrNotDirected) {
+		t.Errorf("Expected error \"%s\", but got \"%v\"", ErrNotDirected, err)
+	}
+}
+
+// newNegativeCycleGraph returns a graph with the negative weight cycle
+// E -> D -> F -> E, which is reachable from A.
+func newNegativeCycleGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Weighted(), gograph.Directed())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	vF := g.AddVertexByLabel("F")
+
+	_, _ = g.AddEdge(vA, vB, gograph.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vB, vC, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vC, vE, gograph.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vE, vD, gograph.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(vD, vF, gograph.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vF, vE, gograph.WithEdgeWeight(-3))
+
+	return g
+}
@@ -111,24 +111,21 @@ func TransitiveReduction[T comparable](g gograph.Graph[T]) (gograph.Graph[T], er
 }
 
 // findDescendants returns a map of all descendants of a vertex in the graph
-// using the depth-first traversal iterator from the traverse package
+// using the iterative depth-first visit from the traverse package
 func findDescendants[T comparable](g gograph.Graph[T], v *gograph.Vertex[T]) map[T]bool {
 	descendants := make(map[T]bool)
 
-	// Process each neighbor of the vertex
-	for _, neighbor := range v.Neighbors() {
-		// Create a depth-first iterator starting from this neighbor
-		dfsIter, err := traverse.NewDepthFirstIterator(g, neighbor.Label())
-		if err != nil {
-			continue
-		}
+	// In a DAG, the vertex is not reachable from itself, so all the other
+	// discovered vertices are descendants
+	_, _ = traverse.DepthFirstVisit(g, v.Label(), &traverse.DFSVisitor[T]{
+		DiscoverVertex: func(descendant *gograph.Vertex[T], _ int) error {
+			if descendant.Label() != v.Label() {
+				descendants[descendant.Label()] = true
+			}
 
-		// Add all other reachable vertices as descendants
-		for dfsIter.HasNext() {
-			descendant := dfsIter.Next()
-			descendants[descendant.Label()] = true
-		}
-	}
+			return nil
+		},
+	})
 
 	return descendants
 }
@@ -52,7 +52,8 @@ type EdgeOptionFunc func(properties *EdgeProperties)
 
 // EdgeProperties represents the properties of an edge.
 type EdgeProperties struct {
-	weight float64
+	weight   float64
+	metadata any
 }
 
 // WithEdgeWeight sets the edge weight for the specified edge
@@ -63,13 +64,22 @@ func WithEdgeWeight(weight float64) EdgeOptionFunc {
 	}
 }
 
+// WithEdgeMetadata sets the metadata for the specified edge
+// properties in the returned EdgeOptionFunc.
+func WithEdgeMetadata(metadata any) EdgeOptionFunc {
+	return func(properties *EdgeProperties) {
+		properties.metadata = metadata
+	}
+}
+
 // VertexOptionFunc represent an alias of function type that
 // modifies the specified vertex properties.
 type VertexOptionFunc func(properties *VertexProperties)
 
 // VertexProperties represents the properties of an edge.
 type VertexProperties struct {
-	weight float64
+	weight   float64
+	metadata any
 }
 
 // WithVertexWeight sets the edge weight for the specified vertex
@@ -79,3 +89,11 @@ func WithVertexWeight(weight float64) VertexOptionFunc {
 		properties.weight = weight
 	}
 }
+
+// WithVertexMetadata sets the metadata for the specified vertex
+// properties in the returned VertexOptionFunc.
+func WithVertexMetadata(metadata any) VertexOptionFunc {
+	return func(properties *VertexProperties) {
+		properties.metadata = metadata
+	}
+}
@@ -0,0 +1,32 @@
+package gograph
+
+// ReverseIndex holds the incoming edges of each vertex of a graph.
+// Vertices only store their outgoing edges, so the searches that follow
+// the edges backward, such as a bidirectional search, need the index.
+// Building it scans all the edges once, in O(E) time, so the searches
+// that run many queries on the same graph should share one index.
+//
+// The index reflects the graph at the time it was built, and it must be
+// rebuilt after the graph changes.
+type ReverseIndex[T comparable] struct {
+	incoming map[T][]*Edge[T]
+}
+
+// NewReverseIndex builds the ReverseIndex of the graph. In undirected
+// graphs, each edge is stored in both directions, so the incoming edges
+// of a vertex mirror its outgoing ones.
+func NewReverseIndex[T comparable](g Graph[T]) *ReverseIndex[T] {
+	r := &ReverseIndex[T]{incoming: make(map[T][]*Edge[T])}
+	for _, edge := range g.AllEdges() {
+		dest := edge.Destination().Label()
+		r.incoming[dest] = append(r.incoming[dest], edge)
+	}
+
+	return r
+}
+
+// IncomingEdges returns the edges to the vertex with the specified
+// label. Unknown labels have no incoming edges.
+func (r *ReverseIndex[T]) IncomingEdges(label T) []*Edge[T] {
+	return r.incoming[label]
+}
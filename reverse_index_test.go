@@ -0,0 +1,41 @@
+package gograph
+
+import (
+	"testing"
+)
+
+func TestReverseIndex(t *testing.T) {
+	g := New[string](Directed(), Weighted())
+	_, _ = g.AddEdge(NewVertex("A"), NewVertex("C"), WithEdgeWeight(1))
+	_, _ = g.AddEdge(NewVertex("B"), NewVertex("C"), WithEdgeWeight(2))
+	_, _ = g.AddEdge(NewVertex("C"), NewVertex("D"), WithEdgeWeight(3))
+
+	index := NewReverseIndex[string](g)
+
+	sources := make(map[string]float64)
+	for _, edge := range index.IncomingEdges("C") {
+		if edge.Destination().Label() != "C" {
+			t.Errorf("expected an edge to C, but got an edge to %s", edge.Destination().Label())
+		}
+
+		sources[edge.Source().Label()] = edge.Weight()
+	}
+
+	if len(sources) != 2 || sources["A"] != 1 || sources["B"] != 2 {
+		t.Errorf("expected the edges from A and B, but got %v", sources)
+	}
+
+	if edges := index.IncomingEdges("A"); len(edges) != 0 {
+		t.Errorf("expected no incoming edges of A, but got %d", len(edges))
+	}
+
+	if edges := index.IncomingEdges("X"); edges != nil {
+		t.Errorf("expected no incoming edges of an unknown label, but got %v", edges)
+	}
+
+	undirected := New[string]()
+	_, _ = undirected.AddEdge(NewVertex("A"), NewVertex("B"))
+	if edges := NewReverseIndex[string](undirected).IncomingEdges("A"); len(edges) != 1 || edges[0].Source().Label() != "B" {
+		t.Errorf("expected the edge from B, but got %v", edges)
+	}
+}
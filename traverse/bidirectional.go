@@ -0,0 +1,132 @@
+package traverse
+
+import (
+	"errors"
+
+	"github.com/hmdsefi/gograph"
+)
+
+var ErrNoPath = errors.New("no path between the vertices")
+
+// BidirectionalBreadthFirstSearch finds a shortest path, in number of
+// edges, from the source to the target. It runs two breadth-first
+// searches, forward from the source and backward from the target along
+// the incoming edges, and always expands the smaller frontier by a whole
+// level. The searches meet in the middle, so on large sparse graphs they
+// visit far fewer vertices than a single search.
+//
+// It returns the vertices of the path from the source to the target. It
+// returns gograph.ErrVertexDoesNotExist if any of the vertices doesn't
+// exist, and ErrNoPath if the target is not reachable from the source.
+func BidirectionalBreadthFirstSearch[T comparable](g gograph.Graph[T], source, target T) ([]*gograph.Vertex[T], error) {
+	if g.GetVertexByID(source) == nil || g.GetVertexByID(target) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	if source == target {
+		return []*gograph.Vertex[T]{g.GetVertexByID(source)}, nil
+	}
+
+	forward := newBFSSide(source, func(label T) []T {
+		return neighborLabels(g.GetVertexByID(label))
+	})
+
+	var incoming map[T][]T
+	backward := newBFSSide(target, func(label T) []T {
+		if incoming == nil {
+			incoming = incomingNeighbors(g)
+		}
+
+		return incoming[label]
+	})
+
+	for len(forward.frontier) > 0 && len(backward.frontier) > 0 {
+		side, other := forward, backward
+		if len(backward.frontier) < len(forward.frontier) {
+			side, other = backward, forward
+		}
+
+		meeting, found := side.expand(other)
+		if !found {
+			continue
+		}
+
+		labels := forward.pathTo(meeting)
+		for label, ok := backward.parent[meeting]; ok; label, ok = backward.parent[label] {
+			labels = append(labels, label)
+		}
+
+		return g.GetAllVerticesByID(labels...), nil
+	}
+
+	return nil, ErrNoPath
+}
+
+// bfsSide is one of the searches of the bidirectional BFS.
+type bfsSide[T comparable] struct {
+	neighbors func(label T) []T
+	frontier  []T
+	depth     map[T]int
+	parent    map[T]T
+}
+
+func newBFSSide[T comparable](start T, neighbors func(label T) []T) *bfsSide[T] {
+	return &bfsSide[T]{
+		neighbors: neighbors,
+		frontier:  []T{start},
+		depth:     map[T]int{start: 0},
+		parent:    make(map[T]T),
+	}
+}
+
+// expand visits the next level of the search. If it reaches a vertex of
+// the other search, it returns the meeting vertex with the shortest total
+// distance after the whole level is expanded.
+func (s *bfsSide[T]) expand(other *bfsSide[T]) (T, bool) {
+	var (
+		meeting T
+		found   bool
+		best    int
+		next    []T
+	)
+
+	for _, label := range s.frontier {
+		for _, neighbor := range s.neighbors(label) {
+			if _, ok := s.depth[neighbor]; !ok {
+				s.depth[neighbor] = s.depth[label] + 1
+				s.parent[neighbor] = label
+				next = append(next, neighbor)
+			}
+
+			otherDepth, ok := other.depth[neighbor]
+			if !ok {
+				continue
+			}
+
+			if total := s.depth[label] + 1 + otherDepth; !found || total < best {
+				// the meeting vertex must be reached through the
+				// current label on this side.
+				if s.depth[neighbor] == s.depth[label]+1 {
+					meeting, best, found = neighbor, total, true
+				}
+			}
+		}
+	}
+
+	s.frontier = next
+	return meeting, found
+}
+
+// pathTo returns the labels from the start of the search to the vertex.
+func (s *bfsSide[T]) pathTo(label T) []T {
+	labels := []T{label}
+	for parent, ok := s.parent[label]; ok; parent, ok = s.parent[parent] {
+		labels = append(labels, parent)
+	}
+
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	return labels
+}
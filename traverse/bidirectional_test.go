@@ -0,0 +1,88 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func labelsOf[T comparable](vertices []*gograph.Vertex[T]) []T {
+	labels := make([]T, len(vertices))
+	for i, v := range vertices {
+		labels[i] = v.Label()
+	}
+
+	return labels
+}
+
+func TestBidirectionalBreadthFirstSearch(t *testing.T) {
+	// the example graph
+	//	1 -> 2 -> 3 -> 4 -> 5
+	//	|              ^
+	//	+----> 6 ------+
+	g := gograph.New[int](gograph.Directed())
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}, {1, 6}, {6, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(7)
+
+	tests := []struct {
+		source, target int
+		expected       []int
+		err            error
+	}{
+		{source: 1, target: 5, expected: []int{1, 6, 4, 5}},
+		{source: 2, target: 5, expected: []int{2, 3, 4, 5}},
+		{source: 3, target: 3, expected: []int{3}},
+		{source: 1, target: 2, expected: []int{1, 2}},
+		{source: 5, target: 1, err: ErrNoPath},
+		{source: 1, target: 7, err: ErrNoPath},
+		{source: 1, target: 9, err: gograph.ErrVertexDoesNotExist},
+	}
+
+	for _, tt := range tests {
+		path, err := BidirectionalBreadthFirstSearch(g, tt.source, tt.target)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%d -> %d: expected error %v, but got %v", tt.source, tt.target, tt.err, err)
+			continue
+		}
+
+		if tt.err == nil && !reflect.DeepEqual(tt.expected, labelsOf(path)) {
+			t.Errorf("%d -> %d: expected path %v, but got %v", tt.source, tt.target, tt.expected, labelsOf(path))
+		}
+	}
+}
+
+func TestBidirectionalBreadthFirstSearch_Grid(t *testing.T) {
+	// the shortest paths of an undirected grid have the Manhattan length.
+	n := 20
+	g := gograph.New[int]()
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i+1 < n {
+				_, _ = g.AddEdge(gograph.NewVertex(i*n+j), gograph.NewVertex((i+1)*n+j))
+			}
+
+			if j+1 < n {
+				_, _ = g.AddEdge(gograph.NewVertex(i*n+j), gograph.NewVertex(i*n+j+1))
+			}
+		}
+	}
+
+	path, err := BidirectionalBreadthFirstSearch(g, 0, n*n-1)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(path) != 2*(n-1)+1 {
+		t.Errorf("Expected a path with %d vertices, but got %d", 2*(n-1)+1, len(path))
+	}
+
+	for i := 1; i < len(path); i++ {
+		if !g.ContainsEdge(path[i-1], path[i]) {
+			t.Errorf("Expected edge %d - %d on the path", path[i-1].Label(), path[i].Label())
+		}
+	}
+}
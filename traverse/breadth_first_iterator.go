@@ -7,32 +7,67 @@ import (
 // breadthFirstIterator is an implementation of the Iterator interface
 // for traversing a graph using a breadth-first search (BFS) algorithm.
 type breadthFirstIterator[T comparable] struct {
-	graph   gograph.Graph[T] // the graph being traversed.
-	start   T                // the label of the starting vertex for the BFS traversal.
-	queue   []T              // a slice that represents the queue of vertices to visit in BFS traversal order.
-	visited map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
-	head    int              // the current head of the queue.
+	graph     gograph.Graph[T] // the graph being traversed.
+	sources   []T              // the labels of the starting vertices for the BFS traversal.
+	queue     []T              // a slice that represents the queue of vertices to visit in BFS traversal order.
+	visited   map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
+	depth     map[T]int        // the depth of each discovered vertex in the traversal tree.
+	parent    map[T]T          // the parent of each discovered vertex, except the start, in the traversal tree.
+	source    map[T]T          // the starting vertex that reached each discovered vertex.
+	options   *Options[T]      // the traversal options.
+	adjacency *adjacency[T]    // the neighbors in the direction of the traversal.
+	head      int              // the current head of the queue.
 }
 
 // NewBreadthFirstIterator creates a new instance of breadthFirstIterator
-// and returns it as the Iterator interface.
-func NewBreadthFirstIterator[T comparable](g gograph.Graph[T], start T) (Iterator[T], error) {
+// and returns it as the Iterator interface. The traversal can be limited
+// by the options, such as WithMaxDepth and WithVisitFilter, and
+// WithDirection makes it follow the incoming edges.
+func NewBreadthFirstIterator[T comparable](g gograph.Graph[T], start T, options ...OptionFunc[T]) (Iterator[T], error) {
 	v := g.GetVertexByID(start)
 	if v == nil {
 		return nil, gograph.ErrVertexDoesNotExist
 	}
 
-	return newBreadthFirstIterator[T](g, start), nil
+	return newBreadthFirstIterator[T](g, []T{start}, newOptions(options...)), nil
 }
 
-func newBreadthFirstIterator[T comparable](g gograph.Graph[T], start T) *breadthFirstIterator[T] {
-	return &breadthFirstIterator[T]{
-		graph:   g,
-		start:   start,
-		queue:   []T{start},
-		visited: map[T]bool{start: true},
-		head:    -1,
+// NewMultiSourceBreadthFirstIterator creates a breadth-first iterator
+// that starts from all the sources at once. The sources are visited
+// first, in the specified order, and then each vertex is visited in the
+// order of its distance to the nearest source.
+//
+// It returns ErrNoSource if no source is specified, and
+// gograph.ErrVertexDoesNotExist if any of the sources doesn't exist in
+// the graph.
+func NewMultiSourceBreadthFirstIterator[T comparable](
+	g gograph.Graph[T],
+	sources []T,
+	options ...OptionFunc[T],
+) (Iterator[T], error) {
+	if len(sources) == 0 {
+		return nil, ErrNoSource
 	}
+
+	for _, source := range sources {
+		if g.GetVertexByID(source) == nil {
+			return nil, gograph.ErrVertexDoesNotExist
+		}
+	}
+
+	return newBreadthFirstIterator[T](g, sources, newOptions(options...)), nil
+}
+
+func newBreadthFirstIterator[T comparable](g gograph.Graph[T], sources []T, options *Options[T]) *breadthFirstIterator[T] {
+	d := &breadthFirstIterator[T]{
+		graph:     g,
+		sources:   sources,
+		options:   options,
+		adjacency: newAdjacency(g, options.direction),
+	}
+
+	d.Reset()
+	return d
 }
 
 // HasNext returns a boolean indicating whether there are more vertices
@@ -56,11 +91,18 @@ func (d *breadthFirstIterator[T]) Next() *gograph.Vertex[T] {
 	currentNode := d.graph.GetVertexByID(d.queue[d.head])
 
 	// add unvisited neighbors to the queue
-	neighbors := currentNode.Neighbors()
-	for _, neighbor := range neighbors {
-		if !d.visited[neighbor.Label()] {
-			d.visited[neighbor.Label()] = true
-			d.queue = append(d.queue, neighbor.Label())
+	depth := d.depth[currentNode.Label()]
+	if !d.options.expands(depth) {
+		return currentNode
+	}
+
+	for _, neighbor := range d.adjacency.neighbors(currentNode) {
+		if !d.visited[neighbor] && d.options.visits(d.graph.GetVertexByID(neighbor)) {
+			d.visited[neighbor] = true
+			d.queue = append(d.queue, neighbor)
+			d.depth[neighbor] = depth + 1
+			d.parent[neighbor] = currentNode.Label()
+			d.source[neighbor] = d.source[currentNode.Label()]
 		}
 	}
 
@@ -82,7 +124,22 @@ func (d *breadthFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) er
 
 // Reset resets the iterator by setting the initial state of the iterator.
 func (d *breadthFirstIterator[T]) Reset() {
-	d.queue = []T{d.start}
+	d.queue = make([]T, 0, len(d.sources))
 	d.head = -1
-	d.visited = map[T]bool{d.start: true}
+	d.visited = make(map[T]bool)
+	d.depth = make(map[T]int)
+	d.parent = make(map[T]T)
+	d.source = make(map[T]T)
+	d.adjacency.reset()
+
+	for _, source := range d.sources {
+		if d.visited[source] {
+			continue
+		}
+
+		d.visited[source] = true
+		d.depth[source] = 0
+		d.source[source] = source
+		d.queue = append(d.queue, source)
+	}
 }
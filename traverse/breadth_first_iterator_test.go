@@ -116,3 +116,91 @@ func TestBreadthFirstIterator(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestBreadthFirstIterator_Options(t *testing.T) {
+	// the example graph
+	//	A -> B -> C -> D
+	//	|
+	//	v
+	//	E -> F
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "E"}, {"E", "F"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	tests := []struct {
+		name     string
+		options  []OptionFunc[string]
+		expected []string
+	}{
+		{name: "max depth 0", options: []OptionFunc[string]{WithMaxDepth[string](0)}, expected: []string{"A"}},
+		{name: "max depth 1", options: []OptionFunc[string]{WithMaxDepth[string](1)}, expected: []string{"A", "B", "E"}},
+		{name: "max depth 2", options: []OptionFunc[string]{WithMaxDepth[string](2)}, expected: []string{"A", "B", "E", "C", "F"}},
+		{
+			name: "visit filter",
+			options: []OptionFunc[string]{WithVisitFilter(func(v *gograph.Vertex[string]) bool {
+				return v.Label() != "B"
+			})},
+			expected: []string{"A", "E", "F"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter, err := NewBreadthFirstIterator(g, "A", tt.options...)
+			if err != nil {
+				t.Fatalf("Expect NewBreadthFirstIterator doesn't return error, but got %s", err)
+			}
+
+			for i := 0; i < 2; i++ {
+				var ordered []string
+				_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+					ordered = append(ordered, v.Label())
+					return nil
+				})
+
+				if !reflect.DeepEqual(tt.expected, ordered) {
+					t.Errorf("Expected %v, but got %v", tt.expected, ordered)
+				}
+
+				// the options apply after reset too.
+				iter.Reset()
+			}
+		})
+	}
+}
+
+func TestBreadthFirstIterator_Direction(t *testing.T) {
+	g := newSearchGraph()
+
+	tests := []struct {
+		start     string
+		direction Direction
+		expected  []string
+	}{
+		{start: "B", direction: Out, expected: []string{"B", "C", "E", "F"}},
+		{start: "E", direction: In, expected: []string{"E", "B", "D", "A"}},
+		{start: "B", direction: Both, expected: []string{"B", "C", "E", "A", "F", "D"}},
+	}
+
+	for _, tt := range tests {
+		iter, err := NewBreadthFirstIterator(g, tt.start, WithDirection[string](tt.direction))
+		if err != nil {
+			t.Fatalf("Expect NewBreadthFirstIterator doesn't return error, but got %s", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			var ordered []string
+			_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+				ordered = append(ordered, v.Label())
+				return nil
+			})
+
+			if !reflect.DeepEqual(tt.expected, ordered) {
+				t.Errorf("Direction %d: expected %v, but got %v", tt.direction, tt.expected, ordered)
+			}
+
+			iter.Reset()
+		}
+	}
+}
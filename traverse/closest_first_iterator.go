@@ -17,32 +17,48 @@ import (
 // The metric for closest here is the weight of the edge between two
 // connected vertices.
 type closestFirstIterator[T comparable] struct {
-	graph    gograph.Graph[T]             // the graph that being traversed.
-	start    T                            // the label of starting point of the traversal.
-	visited  map[T]bool                   // a map that keeps track of whether a vertex has been visited or not.
-	pq       *util.VertexPriorityQueue[T] // a slice of util.VertexWithPriority that represents a min heap.
-	currDist float64                      // the current distance from the start node.
+	graph     gograph.Graph[T]             // the graph that being traversed.
+	start     T                            // the label of starting point of the traversal.
+	visited   map[T]bool                   // a map that keeps track of whether a vertex has been visited or not.
+	pq        *util.VertexPriorityQueue[T] // a slice of util.VertexWithPriority that represents a min heap.
+	currDist  float64                      // the current distance from the start node.
+	dist      map[T]float64                // the shortest known distance of each discovered vertex.
+	parent    map[T]T                      // the vertex before each discovered vertex on its shortest known path.
+	options   *Options[T]                  // the traversal options.
+	adjacency *adjacency[T]                // the neighbors in the direction of the traversal.
 }
 
 // NewClosestFirstIterator creates a new instance of depthFirstIterator
 // and returns it as the Iterator interface.
 //
+// The options WithDirection and WithVisitFilter set the direction of
+// the edges and skip the vertices that the filter rejects. WithMaxDepth
+// doesn't apply to the closest-first traversal.
+//
 // if the start node doesn't exist, returns error.
-func NewClosestFirstIterator[T comparable](graph gograph.Graph[T], start T) (Iterator[T], error) {
+func NewClosestFirstIterator[T comparable](
+	graph gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (Iterator[T], error) {
 	v := graph.GetVertexByID(start)
 	if v == nil {
 		return nil, gograph.ErrVertexDoesNotExist
 	}
 
-	pq := util.NewVertexPriorityQueue[T]()
-	pq.Push(util.NewVertexWithPriority[T](v, 0))
-	return &closestFirstIterator[T]{
-		graph:    graph,
-		start:    start,
-		visited:  make(map[T]bool),
-		pq:       pq,
-		currDist: 0,
-	}, nil
+	return newClosestFirstIterator(graph, start, newOptions(options...)), nil
+}
+
+func newClosestFirstIterator[T comparable](graph gograph.Graph[T], start T, options *Options[T]) *closestFirstIterator[T] {
+	c := &closestFirstIterator[T]{
+		graph:     graph,
+		start:     start,
+		options:   options,
+		adjacency: newAdjacency(graph, options.direction),
+	}
+
+	c.Reset()
+	return c
 }
 
 // HasNext returns a boolean indicating whether there are more vertices
@@ -73,11 +89,16 @@ func (c *closestFirstIterator[T]) Next() *gograph.Vertex[T] {
 	currNode := vp.Vertex()
 	c.visited[currNode.Label()] = true
 
-	neighbors := currNode.Neighbors()
-	for _, neighbor := range neighbors {
-		edge := c.graph.GetEdge(currNode, neighbor)
-		if !c.visited[neighbor.Label()] {
-			dist := c.currDist + edge.Weight()
+	for _, label := range c.adjacency.neighbors(currNode) {
+		neighbor := c.graph.GetVertexByID(label)
+		if c.visited[label] || !c.options.visits(neighbor) {
+			continue
+		}
+
+		dist := c.currDist + c.adjacency.edge(currNode, neighbor).Weight()
+		if best, ok := c.dist[label]; !ok || dist < best {
+			c.dist[label] = dist
+			c.parent[label] = currNode.Label()
 			c.pq.Push(util.NewVertexWithPriority(neighbor, dist))
 		}
 	}
@@ -104,6 +125,9 @@ func (c *closestFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) er
 func (c *closestFirstIterator[T]) Reset() {
 	c.visited = make(map[T]bool)
 	c.currDist = 0
+	c.dist = map[T]float64{c.start: 0}
+	c.parent = make(map[T]T)
+	c.adjacency.reset()
 
 	c.pq = util.NewVertexPriorityQueue[T]()
 	c.pq.Push(util.NewVertexWithPriority(c.graph.GetVertexByID(c.start), 0))
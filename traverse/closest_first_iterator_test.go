@@ -2,6 +2,7 @@ package traverse
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/hmdsefi/gograph"
@@ -139,3 +140,33 @@ func TestClosestFirstIterator_Iterate(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestClosestFirstIterator_Direction(t *testing.T) {
+	g := newSearchGraph()
+
+	tests := []struct {
+		direction Direction
+		expected  []string
+	}{
+		{direction: Out, expected: []string{"C"}},
+		{direction: In, expected: []string{"C", "B", "A"}},
+		{direction: Both, expected: []string{"C", "B", "A", "D", "E", "F"}},
+	}
+
+	for _, tt := range tests {
+		iter, err := NewClosestFirstIterator(g, "C", WithDirection[string](tt.direction))
+		if err != nil {
+			t.Fatalf("Expect NewClosestFirstIterator doesn't return error, but got %s", err)
+		}
+
+		var ordered []string
+		_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+			ordered = append(ordered, v.Label())
+			return nil
+		})
+
+		if !reflect.DeepEqual(tt.expected, ordered) {
+			t.Errorf("Direction %d: expected %v, but got %v", tt.direction, tt.expected, ordered)
+		}
+	}
+}
@@ -7,29 +7,39 @@ import (
 // depthFirstIterator  is an implementation of the Iterator interface
 // for traversing a graph using a depth-first search (DFS) algorithm.
 type depthFirstIterator[T comparable] struct {
-	graph   gograph.Graph[T] // the graph being traversed.
-	start   T                // the label of the starting vertex for the DFS traversal.
-	stack   []T              // a slice that represents the stack of vertices to visit in DFS traversal order.
-	visited map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
+	graph     gograph.Graph[T] // the graph being traversed.
+	start     T                // the label of the starting vertex for the DFS traversal.
+	stack     []T              // a slice that represents the stack of vertices to visit in DFS traversal order.
+	visited   map[T]bool       // a map that keeps track of whether a vertex has been visited or not.
+	depth     map[T]int        // the depth of each discovered vertex in the traversal tree.
+	parent    map[T]T          // the parent of each discovered vertex, except the start, in the traversal tree.
+	options   *Options[T]      // the traversal options.
+	adjacency *adjacency[T]    // the neighbors in the direction of the traversal.
 }
 
 // NewDepthFirstIterator creates a new instance of depthFirstIterator
-// and returns it as the Iterator interface.
-func NewDepthFirstIterator[T comparable](g gograph.Graph[T], start T) (Iterator[T], error) {
+// and returns it as the Iterator interface. The traversal can be limited
+// by the options, such as WithMaxDepth and WithVisitFilter, and
+// WithDirection makes it follow the incoming edges.
+func NewDepthFirstIterator[T comparable](g gograph.Graph[T], start T, options ...OptionFunc[T]) (Iterator[T], error) {
 	v := g.GetVertexByID(start)
 	if v == nil {
 		return nil, gograph.ErrVertexDoesNotExist
 	}
 
-	return newDepthFirstIterator[T](g, start), nil
+	return newDepthFirstIterator[T](g, start, newOptions(options...)), nil
 }
 
-func newDepthFirstIterator[T comparable](g gograph.Graph[T], start T) *depthFirstIterator[T] {
+func newDepthFirstIterator[T comparable](g gograph.Graph[T], start T, options *Options[T]) *depthFirstIterator[T] {
 	return &depthFirstIterator[T]{
-		graph:   g,
-		start:   start,
-		stack:   []T{start},
-		visited: map[T]bool{start: true},
+		graph:     g,
+		start:     start,
+		stack:     []T{start},
+		visited:   map[T]bool{start: true},
+		depth:     map[T]int{start: 0},
+		parent:    make(map[T]T),
+		options:   options,
+		adjacency: newAdjacency(g, options.direction),
 	}
 }
 
@@ -54,11 +64,17 @@ func (d *depthFirstIterator[T]) Next() *gograph.Vertex[T] {
 	currentNode := d.graph.GetVertexByID(label)
 
 	// add unvisited neighbors to the queue
-	neighbors := currentNode.Neighbors()
-	for _, neighbor := range neighbors {
-		if !d.visited[neighbor.Label()] {
-			d.stack = append(d.stack, neighbor.Label())
-			d.visited[neighbor.Label()] = true
+	depth := d.depth[currentNode.Label()]
+	if !d.options.expands(depth) {
+		return currentNode
+	}
+
+	for _, neighbor := range d.adjacency.neighbors(currentNode) {
+		if !d.visited[neighbor] && d.options.visits(d.graph.GetVertexByID(neighbor)) {
+			d.stack = append(d.stack, neighbor)
+			d.visited[neighbor] = true
+			d.depth[neighbor] = depth + 1
+			d.parent[neighbor] = currentNode.Label()
 		}
 	}
 
@@ -82,4 +98,7 @@ func (d *depthFirstIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) erro
 func (d *depthFirstIterator[T]) Reset() {
 	d.stack = []T{d.start}
 	d.visited = map[T]bool{d.start: true}
+	d.depth = map[T]int{d.start: 0}
+	d.parent = make(map[T]T)
+	d.adjacency.reset()
 }
@@ -116,3 +116,67 @@ func TestDepthFirstIterator(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestDepthFirstIterator_Options(t *testing.T) {
+	// the example graph
+	//	A -> B -> C -> D
+	g := gograph.New[string](gograph.Directed())
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	iter, err := NewDepthFirstIterator(g, "A", WithMaxDepth[string](2))
+	if err != nil {
+		t.Fatalf("Expect NewDepthFirstIterator doesn't return error, but got %s", err)
+	}
+
+	var ordered []string
+	_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+		ordered = append(ordered, v.Label())
+		return nil
+	})
+
+	if expected := []string{"A", "B", "C"}; !reflect.DeepEqual(expected, ordered) {
+		t.Errorf("Expected %v, but got %v", expected, ordered)
+	}
+
+	iter, _ = NewDepthFirstIterator(g, "A", WithVisitFilter(func(v *gograph.Vertex[string]) bool {
+		return v.Label() != "C"
+	}))
+
+	ordered = nil
+	_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+		ordered = append(ordered, v.Label())
+		return nil
+	})
+
+	if expected := []string{"A", "B"}; !reflect.DeepEqual(expected, ordered) {
+		t.Errorf("Expected %v, but got %v", expected, ordered)
+	}
+}
+
+func TestDepthFirstIterator_Direction(t *testing.T) {
+	g := newSearchGraph()
+
+	iter, err := NewDepthFirstIterator(g, "F", WithDirection[string](In))
+	if err != nil {
+		t.Fatalf("Expect NewDepthFirstIterator doesn't return error, but got %s", err)
+	}
+
+	var ordered []string
+	_ = iter.Iterate(func(v *gograph.Vertex[string]) error {
+		ordered = append(ordered, v.Label())
+		return nil
+	})
+
+	expected := []string{"F", "E", "D", "A", "B"}
+	if !reflect.DeepEqual(expected, ordered) {
+		t.Errorf("Expected %v, but got %v", expected, ordered)
+	}
+
+	// the undirected exploration reaches all the vertices from a sink.
+	result, _ := DepthFirstSearch(g, "C", WithDirection[string](Both))
+	if len(result.Order()) != 6 {
+		t.Errorf("Expected all the vertices, but got %v", result.Order())
+	}
+}
@@ -0,0 +1,244 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// DFSVisitor contains the callbacks of the depth-first visit events.
+// Nil callbacks are skipped. If a callback returns an error, the visit
+// stops and the error is returned.
+//
+// The edges are classified as in the classic depth-first search:
+//   - a tree edge leads to an undiscovered vertex, and it is part of the
+//     depth-first forest.
+//   - a back edge leads to an ancestor in the depth-first tree, which is
+//     discovered but not finished. Self-loops are back edges.
+//   - a forward edge leads to a finished descendant.
+//   - a cross edge leads to a finished vertex that is not a descendant.
+//
+// Undirected graphs only have tree and back edges. Each undirected edge is
+// reported once, and the reverse direction of a tree edge is skipped.
+type DFSVisitor[T comparable] struct {
+	StartVertex    func(v *gograph.Vertex[T]) error           // called for the root of each depth-first tree.
+	DiscoverVertex func(v *gograph.Vertex[T], time int) error // called when a vertex is discovered.
+	FinishVertex   func(v *gograph.Vertex[T], time int) error // called when all the edges of a vertex are examined.
+	TreeEdge       func(e *gograph.Edge[T]) error
+	BackEdge       func(e *gograph.Edge[T]) error
+	ForwardEdge    func(e *gograph.Edge[T]) error
+	CrossEdge      func(e *gograph.Edge[T]) error
+}
+
+// DFSTimes contains the discovery and finish timestamps of a depth-first
+// visit. The timestamps are taken from a single clock that ticks at each
+// discovery and finish, starting from 1, so for the vertices u and v, v
+// is a descendant of u if and only if the interval of v is nested in the
+// interval of u.
+type DFSTimes[T comparable] struct {
+	discovery map[T]int
+	finish    map[T]int
+}
+
+// Discovery returns the discovery time of the vertex. The second value
+// is false if the vertex was not visited.
+func (d *DFSTimes[T]) Discovery(label T) (int, bool) {
+	time, ok := d.discovery[label]
+	return time, ok
+}
+
+// Finish returns the finish time of the vertex. The second value is false
+// if the vertex was not finished.
+func (d *DFSTimes[T]) Finish(label T) (int, bool) {
+	time, ok := d.finish[label]
+	return time, ok
+}
+
+// DepthFirstVisit runs a depth-first search from the start vertex and
+// calls the visitor callbacks on each event. The neighbors are examined
+// in the order of gograph.Vertex.Neighbors. The search is iterative, so
+// deep graphs don't overflow the call stack.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func DepthFirstVisit[T comparable](g gograph.Graph[T], start T, visitor *DFSVisitor[T]) (*DFSTimes[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	d := newDepthFirstVisit(g, visitor)
+	return d.times, d.visit(start)
+}
+
+// DepthFirstVisitAll runs depth-first searches until all the vertices of
+// the graph are visited, so it covers all the components. The searches
+// start from the specified roots in order, and then from the remaining
+// vertices in the label order.
+//
+// It returns gograph.ErrVertexDoesNotExist if a root doesn't exist in the
+// graph.
+func DepthFirstVisitAll[T comparable](g gograph.Graph[T], visitor *DFSVisitor[T], roots ...T) (*DFSTimes[T], error) {
+	for _, root := range roots {
+		if g.GetVertexByID(root) == nil {
+			return nil, gograph.ErrVertexDoesNotExist
+		}
+	}
+
+	for _, v := range util.SortedVertices(g) {
+		roots = append(roots, v.Label())
+	}
+
+	d := newDepthFirstVisit(g, visitor)
+	for _, root := range roots {
+		if _, ok := d.times.discovery[root]; ok {
+			continue
+		}
+
+		if err := d.visit(root); err != nil {
+			return d.times, err
+		}
+	}
+
+	return d.times, nil
+}
+
+// dfsFrame is a vertex on the stack of the depth-first visit, with the
+// index of its next neighbor to examine.
+type dfsFrame[T comparable] struct {
+	vertex    *gograph.Vertex[T]
+	neighbors []T
+	next      int
+}
+
+type depthFirstVisit[T comparable] struct {
+	graph   gograph.Graph[T]
+	visitor *DFSVisitor[T]
+	times   *DFSTimes[T]
+	parent  map[T]T
+	clock   int
+}
+
+func newDepthFirstVisit[T comparable](g gograph.Graph[T], visitor *DFSVisitor[T]) *depthFirstVisit[T] {
+	if visitor == nil {
+		visitor = &DFSVisitor[T]{}
+	}
+
+	return &depthFirstVisit[T]{
+		graph:   g,
+		visitor: visitor,
+		times: &DFSTimes[T]{
+			discovery: make(map[T]int),
+			finish:    make(map[T]int),
+		},
+		parent: make(map[T]T),
+	}
+}
+
+// visit runs the depth-first search from the root, which is not visited.
+func (d *depthFirstVisit[T]) visit(root T) error {
+	v := d.graph.GetVertexByID(root)
+	if err := call(d.visitor.StartVertex, v); err != nil {
+		return err
+	}
+
+	stack := make([]*dfsFrame[T], 0)
+	discover := func(v *gograph.Vertex[T]) error {
+		d.clock++
+		d.times.discovery[v.Label()] = d.clock
+		stack = append(stack, &dfsFrame[T]{vertex: v, neighbors: neighborLabels(v)})
+		return callTimed(d.visitor.DiscoverVertex, v, d.clock)
+	}
+
+	if err := discover(v); err != nil {
+		return err
+	}
+
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+		if frame.next == len(frame.neighbors) {
+			stack = stack[:len(stack)-1]
+			d.clock++
+			d.times.finish[frame.vertex.Label()] = d.clock
+			if err := callTimed(d.visitor.FinishVertex, frame.vertex, d.clock); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		u := frame.vertex
+		label := frame.neighbors[frame.next]
+		frame.next++
+
+		w := d.graph.GetVertexByID(label)
+		edge := d.graph.GetEdge(u, w)
+
+		discovered, finished := d.times.discovery[label], d.times.finish[label]
+		switch {
+		case discovered == 0:
+			d.parent[label] = u.Label()
+			if err := call(d.visitor.TreeEdge, edge); err != nil {
+				return err
+			}
+
+			if err := discover(w); err != nil {
+				return err
+			}
+		case !d.graph.IsDirected():
+			// the reverse of a tree edge, or of a back edge that was
+			// reported from the descendant, is skipped.
+			if parent, ok := d.parent[u.Label()]; finished != 0 || (ok && parent == label) {
+				continue
+			}
+
+			if err := call(d.visitor.BackEdge, edge); err != nil {
+				return err
+			}
+		case finished == 0:
+			if err := call(d.visitor.BackEdge, edge); err != nil {
+				return err
+			}
+		case d.times.discovery[u.Label()] < discovered:
+			if err := call(d.visitor.ForwardEdge, edge); err != nil {
+				return err
+			}
+		default:
+			if err := call(d.visitor.CrossEdge, edge); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// neighborLabels returns the labels of the neighbors without duplicates,
+// since an undirected self-loop appears twice in the neighbors.
+func neighborLabels[T comparable](v *gograph.Vertex[T]) []T {
+	neighbors := v.Neighbors()
+	labels := make([]T, 0, len(neighbors))
+	seen := make(map[T]bool, len(neighbors))
+	for _, neighbor := range neighbors {
+		if !seen[neighbor.Label()] {
+			seen[neighbor.Label()] = true
+			labels = append(labels, neighbor.Label())
+		}
+	}
+
+	return labels
+}
+
+func call[E any](f func(E) error, arg E) error {
+	if f == nil {
+		return nil
+	}
+
+	return f(arg)
+}
+
+func callTimed[T comparable](f func(*gograph.Vertex[T], int) error, v *gograph.Vertex[T], time int) error {
+	if f == nil {
+		return nil
+	}
+
+	return f(v, time)
+}
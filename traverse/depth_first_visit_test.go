@@ -0,0 +1,198 @@
+package traverse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// recordingVisitor returns a visitor that records all the events.
+func recordingVisitor(events *[]string) *DFSVisitor[int] {
+	edge := func(kind string) func(e *gograph.Edge[int]) error {
+		return func(e *gograph.Edge[int]) error {
+			*events = append(*events, fmt.Sprintf("%s %d-%d", kind, e.Source().Label(), e.Destination().Label()))
+			return nil
+		}
+	}
+
+	return &DFSVisitor[int]{
+		StartVertex: func(v *gograph.Vertex[int]) error {
+			*events = append(*events, fmt.Sprintf("start %d", v.Label()))
+			return nil
+		},
+		DiscoverVertex: func(v *gograph.Vertex[int], time int) error {
+			*events = append(*events, fmt.Sprintf("discover %d@%d", v.Label(), time))
+			return nil
+		},
+		FinishVertex: func(v *gograph.Vertex[int], time int) error {
+			*events = append(*events, fmt.Sprintf("finish %d@%d", v.Label(), time))
+			return nil
+		},
+		TreeEdge:    edge("tree"),
+		BackEdge:    edge("back"),
+		ForwardEdge: edge("forward"),
+		CrossEdge:   edge("cross"),
+	}
+}
+
+func TestDepthFirstVisit(t *testing.T) {
+	// the example graph
+	//	1 -> 2 -> 3 -> 1
+	//	|         ^
+	//	+---------+
+	//	4 -> 3
+	g := gograph.New[int](gograph.Directed())
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {1, 3}, {4, 3}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	if _, err := DepthFirstVisit(g, 9, nil); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	var events []string
+	times, err := DepthFirstVisit(g, 1, recordingVisitor(&events))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := []string{
+		"start 1",
+		"discover 1@1",
+		"tree 1-2",
+		"discover 2@2",
+		"tree 2-3",
+		"discover 3@3",
+		"back 3-1",
+		"finish 3@4",
+		"finish 2@5",
+		"forward 1-3",
+		"finish 1@6",
+	}
+	if !reflect.DeepEqual(expected, events) {
+		t.Errorf("Expected events %v, but got %v", expected, events)
+	}
+
+	if d, _ := times.Discovery(2); d != 2 {
+		t.Errorf("Expected discovery time 2, but got %d", d)
+	}
+
+	if f, _ := times.Finish(1); f != 6 {
+		t.Errorf("Expected finish time 6, but got %d", f)
+	}
+
+	if _, ok := times.Discovery(4); ok {
+		t.Error("Expected vertex 4 not to be visited")
+	}
+}
+
+func TestDepthFirstVisitAll(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	for _, e := range [][2]int{{1, 2}, {3, 2}, {4, 4}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+	g.AddVertexByLabel(5)
+
+	if _, err := DepthFirstVisitAll(g, nil, 9); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	var events []string
+	times, err := DepthFirstVisitAll(g, recordingVisitor(&events), 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := []string{
+		"start 3", "discover 3@1", "tree 3-2", "discover 2@2", "finish 2@3", "finish 3@4",
+		"start 1", "discover 1@5", "cross 1-2", "finish 1@6",
+		"start 4", "discover 4@7", "back 4-4", "finish 4@8",
+		"start 5", "discover 5@9", "finish 5@10",
+	}
+	if !reflect.DeepEqual(expected, events) {
+		t.Errorf("Expected events %v, but got %v", expected, events)
+	}
+
+	for label := 1; label <= 5; label++ {
+		if _, ok := times.Finish(label); !ok {
+			t.Errorf("Expected vertex %d to be finished", label)
+		}
+	}
+}
+
+func TestDepthFirstVisit_Undirected(t *testing.T) {
+	// a triangle 1 - 2 - 3 - 1 with a self-loop on 3.
+	g := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 3}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	var tree, back int
+	_, err := DepthFirstVisit(g, 1, &DFSVisitor[int]{
+		TreeEdge: func(e *gograph.Edge[int]) error {
+			tree++
+			return nil
+		},
+		BackEdge: func(e *gograph.Edge[int]) error {
+			back++
+			return nil
+		},
+		ForwardEdge: func(e *gograph.Edge[int]) error {
+			t.Errorf("Unexpected forward edge %d-%d", e.Source().Label(), e.Destination().Label())
+			return nil
+		},
+		CrossEdge: func(e *gograph.Edge[int]) error {
+			t.Errorf("Unexpected cross edge %d-%d", e.Source().Label(), e.Destination().Label())
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if tree != 2 || back != 2 {
+		t.Errorf("Expected 2 tree edges and 2 back edges, but got %d and %d", tree, back)
+	}
+}
+
+func TestDepthFirstVisit_Error(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+
+	expectedErr := errors.New("stop")
+	discovered := 0
+	_, err := DepthFirstVisit(g, 1, &DFSVisitor[int]{
+		DiscoverVertex: func(v *gograph.Vertex[int], time int) error {
+			discovered++
+			return expectedErr
+		},
+	})
+
+	if !errors.Is(err, expectedErr) || discovered != 1 {
+		t.Errorf("Expected the visit to stop with %s, but got %v after %d vertices", expectedErr, err, discovered)
+	}
+}
+
+func TestDepthFirstVisit_Deep(t *testing.T) {
+	// a long path doesn't overflow the stack.
+	g := gograph.New[int](gograph.Directed())
+	n := 100000
+	prev := g.AddVertexByLabel(0)
+	for i := 1; i < n; i++ {
+		v := g.AddVertexByLabel(i)
+		_, _ = g.AddEdge(prev, v)
+		prev = v
+	}
+
+	times, err := DepthFirstVisit(g, 0, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if f, _ := times.Finish(0); f != 2*n {
+		t.Errorf("Expected finish time %d, but got %d", 2*n, f)
+	}
+}
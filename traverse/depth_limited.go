@@ -0,0 +1,226 @@
+package traverse
+
+import (
+	"errors"
+
+	"github.com/hmdsefi/gograph"
+)
+
+var ErrGoalNotFound = errors.New("no goal vertex is reachable within the depth limit")
+
+// NeighborFunc returns the labels of the vertices next to a vertex of an
+// implicit graph, such as the positions that follow a game position. It
+// is an adapter of gograph.Implicit for the graphs whose edges have no
+// weights, so the searches can explore a state space that is generated
+// on demand from a plain neighbors function.
+type NeighborFunc[T comparable] func(label T) []T
+
+// Successors implements the gograph.Implicit interface, where each edge
+// weighs 1.
+func (f NeighborFunc[T]) Successors(label T) []gograph.Successor[T] {
+	neighbors := f(label)
+	successors := make([]gograph.Successor[T], len(neighbors))
+	for i, neighbor := range neighbors {
+		successors[i] = gograph.Successor[T]{Label: neighbor, Weight: 1}
+	}
+
+	return successors
+}
+
+// GoalResult is the outcome of a goal-directed search.
+type GoalResult[T comparable] struct {
+	Path     []T // the labels from the start vertex to the goal, or nil if no goal is found.
+	Expanded int // the number of vertices whose neighbors were generated.
+}
+
+// Neighbors returns the NeighborFunc of the graph, which follows the
+// edges in the specified direction. The neighbors are in the order of
+// gograph.Vertex.Neighbors, and the unknown labels have no neighbors.
+func Neighbors[T comparable](g gograph.Graph[T], direction Direction) NeighborFunc[T] {
+	adj := newAdjacency(g, direction)
+	return func(label T) []T {
+		v := g.GetVertexByID(label)
+		if v == nil {
+			return nil
+		}
+
+		return adj.neighbors(v)
+	}
+}
+
+// DepthLimitedSearch runs a depth-first search from the start vertex
+// for a vertex that satisfies the goal, and doesn't go deeper than the
+// limit. It returns the path from the start vertex to the first goal
+// that it finds, which is not necessarily the shortest one.
+//
+// The search only avoids the vertices on the current path, instead of
+// all the visited vertices, so it may visit a vertex many times, but
+// it uses O(limit) memory.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph, and ErrGoalNotFound with the number of expanded
+// vertices if there is no goal within the limit.
+func DepthLimitedSearch[T comparable](
+	g gograph.Graph[T],
+	start T,
+	limit int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	return DepthLimitedSearchFunc(gograph.AsImplicit(g), start, limit, goal)
+}
+
+// DepthLimitedSearchFunc runs DepthLimitedSearch on the implicit graph.
+// The edge weights are ignored. A NeighborFunc can be searched as an
+// implicit graph too.
+func DepthLimitedSearchFunc[T comparable](
+	g gograph.Implicit[T],
+	start T,
+	limit int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	result := &GoalResult[T]{}
+	path, _ := depthLimitedSearch(g, start, limit, goal, result)
+	if path == nil {
+		return result, ErrGoalNotFound
+	}
+
+	result.Path = path
+	return result, nil
+}
+
+// IterativeDeepeningSearch runs depth-limited searches from the start
+// vertex with the limits 0, 1, 2, ... up to maxDepth, until it finds a
+// vertex that satisfies the goal. It returns a shortest path in edges
+// to a goal, like BFS, but it uses O(depth) memory like DFS. A negative
+// maxDepth doesn't limit the depth. The search stops when a search
+// doesn't reach its limit, since deeper searches can't find more
+// vertices.
+//
+// The number of expanded vertices is the total of all the searches.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph, and ErrGoalNotFound with the number of expanded
+// vertices if there is no goal within maxDepth.
+func IterativeDeepeningSearch[T comparable](
+	g gograph.Graph[T],
+	start T,
+	maxDepth int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	return IterativeDeepeningSearchFunc(gograph.AsImplicit(g), start, maxDepth, goal)
+}
+
+// IterativeDeepeningSearchFunc runs IterativeDeepeningSearch on the
+// implicit graph. The edge weights are ignored. On an infinite graph
+// without a goal, it only stops at maxDepth.
+func IterativeDeepeningSearchFunc[T comparable](
+	g gograph.Implicit[T],
+	start T,
+	maxDepth int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	result := &GoalResult[T]{}
+	for limit := 0; maxDepth < 0 || limit <= maxDepth; limit++ {
+		path, cutoff := depthLimitedSearch(g, start, limit, goal, result)
+		if path != nil {
+			result.Path = path
+			return result, nil
+		}
+
+		if !cutoff {
+			break
+		}
+	}
+
+	return result, ErrGoalNotFound
+}
+
+// depthLimitedSearch returns the path to the first goal within the limit,
+// or nil. The cutoff is true if the search reached the limit, so a deeper
+// search may find more vertices. It adds the expanded vertices to the
+// result.
+func depthLimitedSearch[T comparable](
+	g gograph.Implicit[T],
+	start T,
+	limit int,
+	goal func(label T) bool,
+	result *GoalResult[T],
+) ([]T, bool) {
+	type frame struct {
+		label     T
+		neighbors []gograph.Successor[T]
+		next      int
+	}
+
+	if limit < 0 {
+		return nil, false
+	}
+
+	var (
+		stack  []*frame
+		onPath = make(map[T]bool)
+		cutoff bool
+	)
+
+	// enter checks the goal, and expands the vertex unless it is at the
+	// limit. It returns true if the vertex is a goal.
+	enter := func(label T) bool {
+		if goal(label) {
+			return true
+		}
+
+		f := &frame{label: label}
+		if len(stack) < limit {
+			f.neighbors = g.Successors(label)
+			result.Expanded++
+		} else {
+			cutoff = true
+		}
+
+		stack = append(stack, f)
+		onPath[label] = true
+		return false
+	}
+
+	path := func(goal T) []T {
+		labels := make([]T, 0, len(stack)+1)
+		for _, f := range stack {
+			labels = append(labels, f.label)
+		}
+
+		return append(labels, goal)
+	}
+
+	if enter(start) {
+		return path(start), cutoff
+	}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		if f.next == len(f.neighbors) {
+			stack = stack[:len(stack)-1]
+			delete(onPath, f.label)
+			continue
+		}
+
+		label := f.neighbors[f.next].Label
+		f.next++
+		if onPath[label] {
+			continue
+		}
+
+		if enter(label) {
+			return path(label), cutoff
+		}
+	}
+
+	return nil, cutoff
+}
@@ -0,0 +1,123 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// gridNeighbors generates the infinite grid, where each cell is next to
+// the four cells around it.
+func gridNeighbors(cell [2]int) [][2]int {
+	return [][2]int{
+		{cell[0] + 1, cell[1]},
+		{cell[0], cell[1] + 1},
+		{cell[0] - 1, cell[1]},
+		{cell[0], cell[1] - 1},
+	}
+}
+
+func TestDepthLimitedSearch(t *testing.T) {
+	g := newSearchGraph()
+	isF := func(label string) bool { return label == "F" }
+
+	result, err := DepthLimitedSearch(g, "A", 3, isF)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual([]string{"A", "B", "E", "F"}, result.Path) || result.Expanded != 4 {
+		t.Errorf("Expected the path A, B, E, F after 4 expansions, but got %v after %d", result.Path, result.Expanded)
+	}
+
+	result, err = DepthLimitedSearch(g, "A", 2, isF)
+	if !errors.Is(err, ErrGoalNotFound) || result.Path != nil || result.Expanded != 3 {
+		t.Errorf("Expected error %s after 3 expansions, but got %v, %v", ErrGoalNotFound, result, err)
+	}
+
+	result, _ = DepthLimitedSearch(g, "A", 0, func(label string) bool { return label == "A" })
+	if !reflect.DeepEqual([]string{"A"}, result.Path) || result.Expanded != 0 {
+		t.Errorf("Expected the start vertex without expansions, but got %v", result)
+	}
+
+	if _, err = DepthLimitedSearch(g, "X", 2, isF); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestIterativeDeepeningSearch(t *testing.T) {
+	g := newSearchGraph()
+
+	result, err := IterativeDeepeningSearch(g, "A", -1, func(label string) bool { return label == "F" })
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	// the searches with the limits 0, 1, 2 and 3 expand 0, 1, 3 and 4 vertices.
+	if !reflect.DeepEqual([]string{"A", "B", "E", "F"}, result.Path) || result.Expanded != 8 {
+		t.Errorf("Expected the path A, B, E, F after 8 expansions, but got %v after %d", result.Path, result.Expanded)
+	}
+
+	// the searches stop when the whole graph is explored.
+	result, err = IterativeDeepeningSearch(g, "A", -1, func(label string) bool { return label == "X" })
+	if !errors.Is(err, ErrGoalNotFound) || result.Expanded == 0 {
+		t.Errorf("Expected error %s, but got %v, %v", ErrGoalNotFound, result, err)
+	}
+
+	if _, err = IterativeDeepeningSearch(g, "X", -1, func(string) bool { return true }); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestIterativeDeepeningSearchFunc(t *testing.T) {
+	target := [2]int{3, -2}
+	isTarget := func(cell [2]int) bool { return cell == target }
+
+	// the infinite grid through a NeighborFunc.
+	grid := NeighborFunc[[2]int](gridNeighbors)
+
+	result, err := IterativeDeepeningSearchFunc(grid, [2]int{0, 0}, -1, isTarget)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(result.Path) != 6 || result.Path[0] != [2]int{0, 0} || result.Path[5] != target {
+		t.Errorf("Expected a shortest path of 5 moves, but got %v", result.Path)
+	}
+
+	for i := 1; i < len(result.Path); i++ {
+		dx, dy := result.Path[i][0]-result.Path[i-1][0], result.Path[i][1]-result.Path[i-1][1]
+		if dx*dx+dy*dy != 1 {
+			t.Errorf("Expected adjacent cells, but got %v", result.Path)
+		}
+	}
+
+	// the infinite grid only stops at the maximum depth.
+	result, err = IterativeDeepeningSearchFunc(grid, [2]int{0, 0}, 4, isTarget)
+	if !errors.Is(err, ErrGoalNotFound) || result.Expanded == 0 {
+		t.Errorf("Expected error %s, but got %v, %v", ErrGoalNotFound, result, err)
+	}
+
+	result, err = DepthLimitedSearchFunc(grid, [2]int{0, 0}, 7, isTarget)
+	if err != nil || len(result.Path) > 8 || result.Path[len(result.Path)-1] != target {
+		t.Errorf("Expected a path within 7 moves, but got %v, %v", result, err)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := newSearchGraph()
+
+	if got := Neighbors(g, Out)("B"); !reflect.DeepEqual([]string{"C", "E"}, got) {
+		t.Errorf("Expected the neighbors C, E, but got %v", got)
+	}
+
+	if got := Neighbors(g, In)("E"); !reflect.DeepEqual([]string{"B", "D"}, got) {
+		t.Errorf("Expected the incoming neighbors B, D, but got %v", got)
+	}
+
+	if got := Neighbors(g, Out)("X"); got != nil {
+		t.Errorf("Expected no neighbors, but got %v", got)
+	}
+}
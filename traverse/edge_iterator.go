@@ -0,0 +1,151 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// treeIterator is a vertex iterator that knows the edge that was used
+// to reach each visited vertex.
+type treeIterator[T comparable] interface {
+	Iterator[T]
+
+	// treeEdge returns the edge between the parent of the vertex and the
+	// vertex, or nil for a starting vertex.
+	treeEdge(v *gograph.Vertex[T]) *gograph.Edge[T]
+}
+
+// treeEdgeIterator implements the EdgeIterator interface on top of a
+// vertex iterator. It yields the tree edge of each visited vertex, and
+// skips the starting vertices, which are not reached by an edge.
+type treeEdgeIterator[T comparable] struct {
+	iter treeIterator[T]  // the underlying vertex iterator.
+	next *gograph.Edge[T] // the edge that the next call of Next returns.
+}
+
+// NewBreadthFirstEdgeIterator creates an iterator that yields the edges
+// of the BFS tree, in the order of the BFS iterator. For each visited
+// vertex, except the start vertex, it yields the edge from its parent.
+// It accepts the same options as NewBreadthFirstIterator.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func NewBreadthFirstEdgeIterator[T comparable](
+	g gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (EdgeIterator[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	return &treeEdgeIterator[T]{iter: newBreadthFirstIterator(g, []T{start}, newOptions(options...))}, nil
+}
+
+// NewDepthFirstEdgeIterator creates an iterator that yields the edges of
+// the DFS tree, in the order of the DFS iterator. For each visited
+// vertex, except the start vertex, it yields the edge from its parent.
+// It accepts the same options as NewDepthFirstIterator.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func NewDepthFirstEdgeIterator[T comparable](
+	g gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (EdgeIterator[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	return &treeEdgeIterator[T]{iter: newDepthFirstIterator(g, start, newOptions(options...))}, nil
+}
+
+// NewClosestFirstEdgeIterator creates an iterator that yields the edges
+// of the shortest-path tree, in the order of the closest-first iterator.
+// For each visited vertex, except the start vertex, it yields the last
+// edge of a shortest path from the start vertex, so the sum of the edge
+// weights up to a vertex is its distance. It accepts the same options as
+// NewClosestFirstIterator.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func NewClosestFirstEdgeIterator[T comparable](
+	g gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (EdgeIterator[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	return &treeEdgeIterator[T]{iter: newClosestFirstIterator(g, start, newOptions(options...))}, nil
+}
+
+// HasNext returns a boolean indicating whether there are more edges to
+// be visited. It advances the underlying iterator past the vertices
+// that are not reached by an edge.
+func (e *treeEdgeIterator[T]) HasNext() bool {
+	for e.next == nil && e.iter.HasNext() {
+		e.next = e.iter.treeEdge(e.iter.Next())
+	}
+
+	return e.next != nil
+}
+
+// Next returns the edge that was used to reach the next visited vertex.
+// If the HasNext is false, returns nil.
+func (e *treeEdgeIterator[T]) Next() *gograph.Edge[T] {
+	if !e.HasNext() {
+		return nil
+	}
+
+	edge := e.next
+	e.next = nil
+	return edge
+}
+
+// Iterate iterates through all the edges in the traversal order and
+// applies the given function to each edge. If the function returns an
+// error, the iteration stops and the error is returned.
+func (e *treeEdgeIterator[T]) Iterate(f func(e *gograph.Edge[T]) error) error {
+	for e.HasNext() {
+		if err := f(e.Next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset resets the iterator by resetting the underlying vertex iterator.
+func (e *treeEdgeIterator[T]) Reset() {
+	e.iter.Reset()
+	e.next = nil
+}
+
+func (d *breadthFirstIterator[T]) treeEdge(v *gograph.Vertex[T]) *gograph.Edge[T] {
+	parent, ok := d.parent[v.Label()]
+	if !ok {
+		return nil
+	}
+
+	return d.adjacency.edge(d.graph.GetVertexByID(parent), v)
+}
+
+func (d *depthFirstIterator[T]) treeEdge(v *gograph.Vertex[T]) *gograph.Edge[T] {
+	parent, ok := d.parent[v.Label()]
+	if !ok {
+		return nil
+	}
+
+	return d.adjacency.edge(d.graph.GetVertexByID(parent), v)
+}
+
+func (c *closestFirstIterator[T]) treeEdge(v *gograph.Vertex[T]) *gograph.Edge[T] {
+	parent, ok := c.parent[v.Label()]
+	if !ok {
+		return nil
+	}
+
+	return c.adjacency.edge(c.graph.GetVertexByID(parent), v)
+}
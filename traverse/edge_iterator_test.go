@@ -0,0 +1,91 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func edgeLabels(t *testing.T, it EdgeIterator[string]) []string {
+	t.Helper()
+
+	var labels []string
+	_ = it.Iterate(func(e *gograph.Edge[string]) error {
+		labels = append(labels, e.Source().Label()+e.Destination().Label())
+		return nil
+	})
+
+	return labels
+}
+
+func TestEdgeIterators(t *testing.T) {
+	g := newSearchGraph()
+
+	tests := []struct {
+		name     string
+		create   func(g gograph.Graph[string], start string, options ...OptionFunc[string]) (EdgeIterator[string], error)
+		options  []OptionFunc[string]
+		start    string
+		expected []string
+	}{
+		{name: "bfs", create: NewBreadthFirstEdgeIterator[string], start: "A", expected: []string{"AB", "AD", "BC", "BE", "EF"}},
+		{name: "dfs", create: NewDepthFirstEdgeIterator[string], start: "A", expected: []string{"AD", "DE", "EF", "AB", "BC"}},
+		// the weights are the edge positions, so E is closer through B.
+		{name: "closest first", create: NewClosestFirstEdgeIterator[string], start: "A", expected: []string{"AB", "AD", "BC", "BE", "EF"}},
+		{
+			name:     "bfs in",
+			create:   NewBreadthFirstEdgeIterator[string],
+			options:  []OptionFunc[string]{WithDirection[string](In)},
+			start:    "E",
+			expected: []string{"BE", "DE", "AB"},
+		},
+		{
+			name:     "closest first visit filter",
+			create:   NewClosestFirstEdgeIterator[string],
+			options:  []OptionFunc[string]{WithVisitFilter(func(v *gograph.Vertex[string]) bool { return v.Label() != "B" })},
+			start:    "A",
+			expected: []string{"AD", "DE", "EF"},
+		},
+	}
+
+	for _, tt := range tests {
+		it, err := tt.create(g, tt.start, tt.options...)
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got %s", tt.name, err)
+		}
+
+		for i := 0; i < 2; i++ {
+			if got := edgeLabels(t, it); !reflect.DeepEqual(tt.expected, got) {
+				t.Errorf("%s: expected edges %v, but got %v", tt.name, tt.expected, got)
+			}
+
+			if it.HasNext() || it.Next() != nil {
+				t.Errorf("%s: expected no more edges", tt.name)
+			}
+
+			it.Reset()
+		}
+
+		if _, err = tt.create(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+			t.Errorf("%s: expected error %s, but got %v", tt.name, gograph.ErrVertexDoesNotExist, err)
+		}
+	}
+}
+
+func TestClosestFirstEdgeIterator_Distances(t *testing.T) {
+	g := newSearchGraph()
+
+	it, _ := NewClosestFirstEdgeIterator(g, "A")
+	distances := map[string]float64{"A": 0}
+	_ = it.Iterate(func(e *gograph.Edge[string]) error {
+		distances[e.Destination().Label()] = distances[e.Source().Label()] + e.Weight()
+		return nil
+	})
+
+	expected := map[string]float64{"A": 0, "B": 1, "C": 4, "D": 2, "E": 5, "F": 11}
+	if !reflect.DeepEqual(expected, distances) {
+		t.Errorf("Expected distances %v, but got %v", expected, distances)
+	}
+}
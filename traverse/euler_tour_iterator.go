@@ -0,0 +1,177 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// TourEventKind is the kind of an Euler tour event.
+type TourEventKind int
+
+const (
+	// EnterVertex is the event of entering a vertex, when the traversal
+	// discovers it.
+	EnterVertex TourEventKind = iota
+
+	// LeaveVertex is the event of leaving a vertex, when all the vertices
+	// below it in the traversal tree have been left.
+	LeaveVertex
+)
+
+// TourEvent is an event of an Euler tour of the depth-first tree.
+type TourEvent[T comparable] struct {
+	Kind   TourEventKind      // entering or leaving the vertex.
+	Vertex *gograph.Vertex[T] // the vertex that is entered or left.
+	Edge   *gograph.Edge[T]   // the tree edge between the parent and the vertex, nil for the start vertex.
+	Depth  int                // the depth of the vertex in the depth-first tree.
+}
+
+// EulerTourIterator represents an iterator over the events of an Euler
+// tour. Each vertex of the depth-first tree is entered once and left
+// once, and the events of its descendants are nested between them.
+type EulerTourIterator[T comparable] interface {
+	// HasNext returns a boolean value indicating whether there are more
+	// events to be iterated over.
+	HasNext() bool
+
+	// Next returns the next event of the tour. If there are no more
+	// events, it returns nil.
+	Next() *TourEvent[T]
+
+	// Iterate iterates over all the events and calls the provided callback
+	// function on each event. If the callback function returns an error,
+	// iteration is stopped and the error is returned.
+	Iterate(func(e *TourEvent[T]) error) error
+
+	// Reset resets the iterator to its initial state, allowing the
+	// events to be iterated over again from the beginning.
+	Reset()
+}
+
+// tourFrame is a vertex on the path from the start vertex to the current
+// vertex of the tour.
+type tourFrame[T comparable] struct {
+	vertex    *gograph.Vertex[T]
+	edge      *gograph.Edge[T]
+	depth     int
+	neighbors []T
+	next      int
+}
+
+// eulerTourIterator implements the EulerTourIterator interface with an
+// iterative depth-first search, which keeps the path to the current
+// vertex on a stack.
+type eulerTourIterator[T comparable] struct {
+	graph     gograph.Graph[T] // the graph being traversed.
+	start     T                // the label of the starting vertex of the tour.
+	stack     []*tourFrame[T]  // the path from the start vertex to the current vertex.
+	visited   map[T]bool       // a map that keeps track of whether a vertex has been entered or not.
+	started   bool             // whether the start vertex has been entered.
+	options   *Options[T]      // the traversal options.
+	adjacency *adjacency[T]    // the neighbors in the direction of the traversal.
+}
+
+// NewEulerTourIterator creates an iterator that yields the entering and
+// leaving events of a depth-first traversal from the start vertex. The
+// neighbors are entered in the order of gograph.Vertex.Neighbors, like
+// DepthFirstVisit. The traversal can be limited by the options, such as
+// WithMaxDepth and WithVisitFilter, and WithDirection makes it follow
+// the incoming edges.
+//
+// The tour gives the subtree of each vertex as an interval of events,
+// which is used for subtree queries and lowest common ancestors.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func NewEulerTourIterator[T comparable](
+	g gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (EulerTourIterator[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	opts := newOptions(options...)
+	e := &eulerTourIterator[T]{
+		graph:     g,
+		start:     start,
+		options:   opts,
+		adjacency: newAdjacency(g, opts.direction),
+	}
+
+	e.Reset()
+	return e, nil
+}
+
+// HasNext returns a boolean indicating whether there are more events.
+// It returns true until the start vertex is left.
+func (e *eulerTourIterator[T]) HasNext() bool {
+	return !e.started || len(e.stack) > 0
+}
+
+// Next returns the next event of the tour. It enters the next unvisited
+// neighbor of the current vertex, or leaves the current vertex if all
+// its neighbors are visited.
+// If the HasNext is false, returns nil.
+func (e *eulerTourIterator[T]) Next() *TourEvent[T] {
+	if !e.HasNext() {
+		return nil
+	}
+
+	if !e.started {
+		e.started = true
+		return e.enter(e.graph.GetVertexByID(e.start), nil, 0)
+	}
+
+	for {
+		frame := e.stack[len(e.stack)-1]
+		if frame.next == len(frame.neighbors) {
+			e.stack = e.stack[:len(e.stack)-1]
+			return &TourEvent[T]{Kind: LeaveVertex, Vertex: frame.vertex, Edge: frame.edge, Depth: frame.depth}
+		}
+
+		label := frame.neighbors[frame.next]
+		frame.next++
+
+		neighbor := e.graph.GetVertexByID(label)
+		if e.visited[label] || !e.options.visits(neighbor) {
+			continue
+		}
+
+		return e.enter(neighbor, e.adjacency.edge(frame.vertex, neighbor), frame.depth+1)
+	}
+}
+
+// Iterate iterates through all the events of the tour and applies the
+// given function to each event. If the function returns an error, the
+// iteration stops and the error is returned.
+func (e *eulerTourIterator[T]) Iterate(f func(e *TourEvent[T]) error) error {
+	for e.HasNext() {
+		if err := f(e.Next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset resets the iterator by setting the initial state of the iterator.
+func (e *eulerTourIterator[T]) Reset() {
+	e.stack = make([]*tourFrame[T], 0)
+	e.visited = make(map[T]bool)
+	e.started = false
+	e.adjacency.reset()
+}
+
+// enter pushes the vertex on the stack, and returns its entering event.
+func (e *eulerTourIterator[T]) enter(v *gograph.Vertex[T], edge *gograph.Edge[T], depth int) *TourEvent[T] {
+	e.visited[v.Label()] = true
+
+	frame := &tourFrame[T]{vertex: v, edge: edge, depth: depth}
+	if e.options.expands(depth) {
+		frame.neighbors = e.adjacency.neighbors(v)
+	}
+
+	e.stack = append(e.stack, frame)
+	return &TourEvent[T]{Kind: EnterVertex, Vertex: v, Edge: edge, Depth: depth}
+}
@@ -0,0 +1,88 @@
+package traverse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func tourEvents(t *testing.T, it EulerTourIterator[string]) []string {
+	t.Helper()
+
+	var events []string
+	_ = it.Iterate(func(e *TourEvent[string]) error {
+		kind := "enter"
+		if e.Kind == LeaveVertex {
+			kind = "leave"
+		}
+
+		events = append(events, fmt.Sprintf("%s %s %d", kind, e.Vertex.Label(), e.Depth))
+		return nil
+	})
+
+	return events
+}
+
+func TestEulerTourIterator(t *testing.T) {
+	g := newSearchGraph()
+
+	it, err := NewEulerTourIterator(g, "A")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := []string{
+		"enter A 0",
+		"enter B 1",
+		"enter C 2",
+		"leave C 2",
+		"enter E 2",
+		"enter F 3",
+		"leave F 3",
+		"leave E 2",
+		"leave B 1",
+		"enter D 1",
+		"leave D 1",
+		"leave A 0",
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := tourEvents(t, it); !reflect.DeepEqual(expected, got) {
+			t.Errorf("Expected events %v, but got %v", expected, got)
+		}
+
+		if it.HasNext() || it.Next() != nil {
+			t.Error("Expected no more events")
+		}
+
+		it.Reset()
+	}
+
+	if _, err = NewEulerTourIterator(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
+
+func TestEulerTourIterator_Edges(t *testing.T) {
+	g := newSearchGraph()
+
+	it, _ := NewEulerTourIterator(g, "F", WithDirection[string](In), WithMaxDepth[string](2))
+	var events []string
+	_ = it.Iterate(func(e *TourEvent[string]) error {
+		if e.Edge == nil {
+			events = append(events, e.Vertex.Label())
+			return nil
+		}
+
+		events = append(events, e.Edge.Source().Label()+e.Edge.Destination().Label())
+		return nil
+	})
+
+	expected := []string{"F", "EF", "BE", "BE", "DE", "DE", "EF", "F"}
+	if !reflect.DeepEqual(expected, events) {
+		t.Errorf("Expected events %v, but got %v", expected, events)
+	}
+}
@@ -0,0 +1,154 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// BreadthFirstGoalSearch runs a breadth-first search on the implicit
+// graph from the start vertex, until it finds a vertex that satisfies
+// the goal. It returns a shortest path in edges to the goal, and the
+// number of expanded vertices. The edge weights are ignored. A graph can
+// be searched through gograph.AsImplicit.
+//
+// The vertices at maxDepth are not expanded, and a negative maxDepth
+// doesn't limit the depth. On an infinite graph without a reachable
+// goal, the search only stops at maxDepth.
+//
+// It returns ErrGoalNotFound with the number of expanded vertices if no
+// goal is reachable within maxDepth.
+func BreadthFirstGoalSearch[T comparable](
+	g gograph.Implicit[T],
+	start T,
+	maxDepth int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	result := &GoalResult[T]{}
+	if goal(start) {
+		result.Path = []T{start}
+		return result, nil
+	}
+
+	parent := make(map[T]T)
+	depth := map[T]int{start: 0}
+	queue := []T{start}
+	for head := 0; head < len(queue); head++ {
+		label := queue[head]
+		if maxDepth >= 0 && depth[label] >= maxDepth {
+			continue
+		}
+
+		result.Expanded++
+		for _, successor := range g.Successors(label) {
+			next := successor.Label
+			if _, ok := depth[next]; ok {
+				continue
+			}
+
+			parent[next] = label
+			depth[next] = depth[label] + 1
+			if goal(next) {
+				result.Path = goalPath(parent, start, next)
+				return result, nil
+			}
+
+			queue = append(queue, next)
+		}
+	}
+
+	return result, ErrGoalNotFound
+}
+
+// DepthFirstGoalSearch runs a depth-first search on the implicit graph
+// from the start vertex, until it finds a vertex that satisfies the
+// goal. It returns the path to the first goal that it finds, which is
+// not necessarily the shortest one, and the number of expanded vertices.
+// A graph can be searched through gograph.AsImplicit.
+//
+// It keeps the shallowest depth at which each vertex was reached, so the
+// memory grows with the visited vertices. With a depth limit, a vertex is
+// expanded again if it is reached by a shorter path, which can bring a
+// goal within maxDepth. The vertices at maxDepth are not expanded, and a negative
+// maxDepth doesn't limit the depth. DepthLimitedSearchFunc only keeps
+// the current path instead.
+//
+// It returns ErrGoalNotFound with the number of expanded vertices if no
+// goal is reachable within maxDepth.
+func DepthFirstGoalSearch[T comparable](
+	g gograph.Implicit[T],
+	start T,
+	maxDepth int,
+	goal func(label T) bool,
+) (*GoalResult[T], error) {
+	type frame struct {
+		label      T
+		successors []gograph.Successor[T]
+		next       int
+	}
+
+	result := &GoalResult[T]{}
+	depth := make(map[T]int)
+	var stack []*frame
+
+	// enter returns the path if the vertex is a goal, and pushes it on
+	// the stack otherwise.
+	enter := func(label T) []T {
+		depth[label] = len(stack)
+
+		f := &frame{label: label}
+		if goal(label) {
+			path := make([]T, 0, len(stack)+1)
+			for _, parent := range stack {
+				path = append(path, parent.label)
+			}
+
+			return append(path, label)
+		}
+
+		if maxDepth < 0 || len(stack) < maxDepth {
+			f.successors = g.Successors(label)
+			result.Expanded++
+		}
+
+		stack = append(stack, f)
+		return nil
+	}
+
+	if result.Path = enter(start); result.Path != nil {
+		return result, nil
+	}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		if f.next == len(f.successors) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		label := f.successors[f.next].Label
+		f.next++
+		if d, ok := depth[label]; ok && (maxDepth < 0 || d <= len(stack)) {
+			continue
+		}
+
+		if result.Path = enter(label); result.Path != nil {
+			return result, nil
+		}
+	}
+
+	return result, ErrGoalNotFound
+}
+
+// goalPath follows the parents from the goal back to the start.
+func goalPath[T comparable](parent map[T]T, start, goal T) []T {
+	path := []T{goal}
+	for label := goal; label != start; {
+		label = parent[label]
+		path = append(path, label)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
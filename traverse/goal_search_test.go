@@ -0,0 +1,75 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestBreadthFirstGoalSearch(t *testing.T) {
+	g := gograph.AsImplicit(newSearchGraph())
+
+	result, err := BreadthFirstGoalSearch(g, "A", -1, func(label string) bool { return label == "F" })
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	// A, B, D and C are expanded before E discovers F.
+	if !reflect.DeepEqual([]string{"A", "B", "E", "F"}, result.Path) || result.Expanded != 5 {
+		t.Errorf("Expected the path A, B, E, F after 5 expansions, but got %v after %d", result.Path, result.Expanded)
+	}
+
+	result, err = BreadthFirstGoalSearch(g, "A", 2, func(label string) bool { return label == "F" })
+	if !errors.Is(err, ErrGoalNotFound) || result.Path != nil {
+		t.Errorf("Expected error %s, but got %v, %v", ErrGoalNotFound, result, err)
+	}
+
+	result, _ = BreadthFirstGoalSearch(g, "A", -1, func(label string) bool { return label == "A" })
+	if !reflect.DeepEqual([]string{"A"}, result.Path) {
+		t.Errorf("Expected the start vertex, but got %v", result.Path)
+	}
+
+	// the infinite grid through a NeighborFunc.
+	grid := NeighborFunc[[2]int](gridNeighbors)
+	result2, err := BreadthFirstGoalSearch(grid, [2]int{0, 0}, -1, func(cell [2]int) bool { return cell == [2]int{-2, 3} })
+	if err != nil || len(result2.Path) != 6 {
+		t.Errorf("Expected a shortest path of 5 moves, but got %v, %v", result2, err)
+	}
+}
+
+func TestDepthFirstGoalSearch(t *testing.T) {
+	g := gograph.AsImplicit(newSearchGraph())
+
+	result, err := DepthFirstGoalSearch(g, "A", -1, func(label string) bool { return label == "F" })
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual([]string{"A", "B", "E", "F"}, result.Path) || result.Expanded != 4 {
+		t.Errorf("Expected the path A, B, E, F after 4 expansions, but got %v after %d", result.Path, result.Expanded)
+	}
+
+	// D reaches E again, but E is expanded once.
+	result, err = DepthFirstGoalSearch(g, "A", -1, func(label string) bool { return label == "X" })
+	if !errors.Is(err, ErrGoalNotFound) || result.Expanded != 6 {
+		t.Errorf("Expected error %s after 6 expansions, but got %v, %v", ErrGoalNotFound, result, err)
+	}
+
+	// A is first reached at the depth limit through X, and then again by
+	// the shorter path S, A, which reaches G within the limit.
+	diamond := NeighborFunc[string](func(label string) []string {
+		return map[string][]string{"S": {"X", "A"}, "X": {"A"}, "A": {"G"}}[label]
+	})
+	result, err = DepthFirstGoalSearch(diamond, "S", 2, func(label string) bool { return label == "G" })
+	if err != nil || !reflect.DeepEqual([]string{"S", "A", "G"}, result.Path) {
+		t.Errorf("Expected the path S, A, G, but got %v, %v", result, err)
+	}
+
+	grid := NeighborFunc[[2]int](gridNeighbors)
+	result2, err := DepthFirstGoalSearch(grid, [2]int{0, 0}, 3, func(cell [2]int) bool { return cell == [2]int{1, 1} })
+	if err != nil || result2.Path[len(result2.Path)-1] != [2]int{1, 1} || len(result2.Path) > 4 {
+		t.Errorf("Expected a path within 3 moves, but got %v, %v", result2, err)
+	}
+}
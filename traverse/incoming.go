@@ -0,0 +1,123 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// incomingNeighbors returns the labels of the vertices that have an edge
+// to each vertex, in the label order. Vertices only store their outgoing
+// neighbors, so it builds the gograph.ReverseIndex of a directed graph,
+// which scans all the edges once. In undirected graphs, the incoming
+// neighbors are the neighbors.
+func incomingNeighbors[T comparable](g gograph.Graph[T]) map[T][]T {
+	vertices := g.GetAllVertices()
+	incoming := make(map[T][]T, len(vertices))
+	if !g.IsDirected() {
+		for _, v := range vertices {
+			incoming[v.Label()] = neighborLabels(v)
+		}
+
+		return incoming
+	}
+
+	index := gograph.NewReverseIndex(g)
+	for _, v := range vertices {
+		edges := index.IncomingEdges(v.Label())
+		if len(edges) == 0 {
+			continue
+		}
+
+		labels := make([]T, len(edges))
+		for i, edge := range edges {
+			labels[i] = edge.Source().Label()
+		}
+
+		util.SortLabels(labels)
+		incoming[v.Label()] = labels
+	}
+
+	return incoming
+}
+
+// adjacency finds the vertices next to a vertex in a traversal direction.
+// The incoming neighbors are collected on the first use, and reset drops
+// them, so a reset traversal sees the changes of the graph.
+type adjacency[T comparable] struct {
+	graph     gograph.Graph[T]
+	direction Direction
+	incoming  map[T][]T
+}
+
+func newAdjacency[T comparable](g gograph.Graph[T], direction Direction) *adjacency[T] {
+	if !g.IsDirected() {
+		direction = Out
+	}
+
+	return &adjacency[T]{graph: g, direction: direction}
+}
+
+// neighbors returns the labels of the vertices next to v without
+// duplicates: the outgoing neighbors in the order of
+// gograph.Vertex.Neighbors, and then the incoming neighbors in the
+// label order.
+func (a *adjacency[T]) neighbors(v *gograph.Vertex[T]) []T {
+	switch a.direction {
+	case In:
+		return a.incomingOf(v.Label())
+	case Both:
+		labels := neighborLabels(v)
+		seen := make(map[T]bool, len(labels))
+		for _, label := range labels {
+			seen[label] = true
+		}
+
+		for _, label := range a.incomingOf(v.Label()) {
+			if !seen[label] {
+				labels = append(labels, label)
+			}
+		}
+
+		return labels
+	default:
+		return neighborLabels(v)
+	}
+}
+
+// edge returns the edge that the traversal follows from one vertex to
+// the other. In the In direction, it is the edge from the other vertex.
+// In the Both direction, the outgoing edge is preferred.
+func (a *adjacency[T]) edge(from, to *gograph.Vertex[T]) *gograph.Edge[T] {
+	switch a.direction {
+	case In:
+		return a.graph.GetEdge(to, from)
+	case Both:
+		if edge := a.graph.GetEdge(from, to); edge != nil {
+			return edge
+		}
+
+		return a.graph.GetEdge(to, from)
+	default:
+		return a.graph.GetEdge(from, to)
+	}
+}
+
+func (a *adjacency[T]) incomingOf(label T) []T {
+	if a.incoming == nil {
+		a.incoming = incomingNeighbors(a.graph)
+	}
+
+	return a.incoming[label]
+}
+
+// load collects the incoming neighbors that the direction needs, so the
+// adjacency can be shared by goroutines that only read it.
+func (a *adjacency[T]) load() {
+	if a.direction != Out && a.incoming == nil {
+		a.incoming = incomingNeighbors(a.graph)
+	}
+}
+
+func (a *adjacency[T]) reset() {
+	a.incoming = nil
+}
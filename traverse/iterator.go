@@ -32,3 +32,25 @@ type Iterator[T comparable] interface {
 	// sequence to be iterated over again from the beginning.
 	Reset()
 }
+
+// EdgeIterator represents an iterator over the edges of a traversal. It
+// yields the edge that was used to reach each vertex, in the order that
+// the vertices are visited, so the edges form the traversal tree.
+type EdgeIterator[T comparable] interface {
+	// HasNext returns a boolean value indicating whether there are more
+	// edges to be iterated over.
+	HasNext() bool
+
+	// Next returns the next edge of the traversal. If there are no more
+	// edges, it returns nil.
+	Next() *gograph.Edge[T]
+
+	// Iterate iterates over all the edges and calls the provided callback
+	// function on each edge. If the callback function returns an error,
+	// iteration is stopped and the error is returned.
+	Iterate(func(e *gograph.Edge[T]) error) error
+
+	// Reset resets the iterator to its initial state, allowing the
+	// edges to be iterated over again from the beginning.
+	Reset()
+}
@@ -0,0 +1,82 @@
+package traverse
+
+import (
+	"github.com/hmdsefi/gograph"
+)
+
+// OptionFunc represent an alias of function type that
+// modifies the specified traversal options.
+type OptionFunc[T comparable] func(options *Options[T])
+
+// Direction is the direction of the edges that a traversal follows.
+type Direction int
+
+const (
+	// Out follows the outgoing edges, from each vertex to its neighbors.
+	// It is the default direction.
+	Out Direction = iota
+
+	// In follows the incoming edges backwards, from each vertex to the
+	// vertices that have an edge to it, e.g. from a package to all the
+	// packages that depend on it.
+	In
+
+	// Both follows the edges in both directions, which explores a
+	// directed graph as if it were undirected.
+	Both
+)
+
+// Options represents the options of the traversals.
+type Options[T comparable] struct {
+	maxDepth    int
+	visitFilter func(v *gograph.Vertex[T]) bool
+	direction   Direction
+	workers     int
+}
+
+// WithMaxDepth stops the traversal at the specified depth, so only the
+// vertices that are at most maxDepth edges away from the start vertex,
+// following the traversal tree, are visited. A negative maxDepth, which
+// is the default, doesn't limit the depth.
+func WithMaxDepth[T comparable](maxDepth int) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.maxDepth = maxDepth
+	}
+}
+
+// WithVisitFilter sets a function that decides whether a vertex is
+// visited. The traversal skips the vertices that the filter rejects,
+// and doesn't continue through them. The start vertex is always visited.
+func WithVisitFilter[T comparable](filter func(v *gograph.Vertex[T]) bool) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.visitFilter = filter
+	}
+}
+
+// WithDirection sets the direction of the edges that the traversal
+// follows. In undirected graphs, all the directions are the same.
+func WithDirection[T comparable](direction Direction) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.direction = direction
+	}
+}
+
+func newOptions[T comparable](options ...OptionFunc[T]) *Options[T] {
+	opts := &Options[T]{maxDepth: -1}
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
+
+// expands reports whether the neighbors of a vertex at the specified
+// depth can be visited.
+func (o *Options[T]) expands(depth int) bool {
+	return o.maxDepth < 0 || depth < o.maxDepth
+}
+
+// visits reports whether the vertex passes the visit filter.
+func (o *Options[T]) visits(v *gograph.Vertex[T]) bool {
+	return o.visitFilter == nil || o.visitFilter(v)
+}
@@ -0,0 +1,342 @@
+package traverse
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hmdsefi/gograph"
+)
+
+const (
+	// the thresholds of the direction-optimizing BFS by Beamer et al.:
+	// it switches to bottom-up when the frontier has more than 1/alpha of
+	// the edges of the unvisited vertices, and back to top-down when the
+	// frontier has less than 1/beta of the vertices.
+	bfsAlpha = 14
+	bfsBeta  = 24
+
+	noClaim = math.MaxInt64
+)
+
+// WithParallelism sets the number of goroutines of the parallel
+// traversals, such as ParallelBreadthFirstSearch. The default is
+// runtime.GOMAXPROCS(0).
+func WithParallelism[T comparable](workers int) OptionFunc[T] {
+	return func(options *Options[T]) {
+		options.workers = workers
+	}
+}
+
+// ParallelBreadthFirstSearch traverses the graph from the start vertex
+// level by level, and expands each level across a pool of goroutines. It
+// returns the same result as BreadthFirstSearch with the same options:
+// the same discovery order, depths and parents.
+//
+// The search is direction-optimizing: while the frontier is small, it
+// expands the edges of the frontier (top-down), and when the frontier
+// is large, it checks the unvisited vertices for a parent in the
+// frontier instead (bottom-up), which examines fewer edges on the large
+// levels of small-world graphs.
+//
+// The graph is copied to an indexed adjacency list first, which takes
+// O(V + E) memory, so the search pays off on large graphs. The visit
+// filter is called once for each vertex, before the search starts.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func ParallelBreadthFirstSearch[T comparable](
+	g gograph.Graph[T],
+	start T,
+	options ...OptionFunc[T],
+) (*SearchResult[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	p := newParallelBFS(g, newOptions(options...))
+	p.run(p.index[start])
+	return p.result(g, start), nil
+}
+
+// bfsInEdge is an edge from the vertex from, where the edge target is
+// the rank-th neighbor of from.
+type bfsInEdge struct {
+	from int
+	rank int
+}
+
+// bfsClaim is a vertex of the next level, and the key of its parent:
+// the frontier index of the parent in the high bits and the rank of the
+// vertex in the neighbors of the parent in the low bits. The sequential
+// BFS discovers each vertex from the parent with the smallest key, in
+// the order of the keys.
+type bfsClaim struct {
+	vertex int
+	key    int64
+}
+
+type parallelBFS[T comparable] struct {
+	options *Options[T]
+	workers int
+	alpha   int
+	beta    int
+
+	labels   []T           // the label of each vertex index.
+	index    map[T]int     // the index of each vertex label.
+	out      [][]int       // the neighbors of each vertex in the direction of the traversal.
+	in       [][]bfsInEdge // the edges to each vertex in the direction of the traversal.
+	allowed  []bool        // whether each vertex passes the visit filter.
+	depth    []int         // the depth of each vertex, or -1 if it is not visited.
+	parent   []int         // the parent of each vertex.
+	position []int         // the index of each vertex in its level.
+	key      []int64       // the smallest parent key of each vertex in a top-down step.
+	order    []int         // the visited vertices in the discovery order.
+}
+
+func newParallelBFS[T comparable](g gograph.Graph[T], options *Options[T]) *parallelBFS[T] {
+	vertices := g.GetAllVertices()
+	n := len(vertices)
+
+	p := &parallelBFS[T]{
+		options:  options,
+		workers:  options.workers,
+		alpha:    bfsAlpha,
+		beta:     bfsBeta,
+		labels:   make([]T, n),
+		index:    make(map[T]int, n),
+		out:      make([][]int, n),
+		in:       make([][]bfsInEdge, n),
+		allowed:  make([]bool, n),
+		depth:    make([]int, n),
+		parent:   make([]int, n),
+		position: make([]int, n),
+		key:      make([]int64, n),
+	}
+
+	if p.workers < 1 {
+		p.workers = runtime.GOMAXPROCS(0)
+	}
+
+	for i, v := range vertices {
+		p.labels[i] = v.Label()
+		p.index[v.Label()] = i
+		p.allowed[i] = options.visits(v)
+		p.depth[i] = -1
+		p.key[i] = noClaim
+	}
+
+	adj := newAdjacency(g, options.direction)
+	adj.load()
+	p.parallel(n, func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			labels := adj.neighbors(vertices[i])
+			p.out[i] = make([]int, len(labels))
+			for r, label := range labels {
+				p.out[i][r] = p.index[label]
+			}
+		}
+	})
+
+	for from, neighbors := range p.out {
+		for rank, to := range neighbors {
+			p.in[to] = append(p.in[to], bfsInEdge{from: from, rank: rank})
+		}
+	}
+
+	return p
+}
+
+// run visits the vertices level by level, starting from the source.
+func (p *parallelBFS[T]) run(source int) {
+	p.depth[source] = 0
+	frontier := []int{source}
+
+	// the number of edges that the bottom-up steps may examine
+	unexplored := 0
+	for _, edges := range p.in {
+		unexplored += len(edges)
+	}
+	unexplored -= len(p.in[source])
+
+	bottomUp := false
+	for level := 0; len(frontier) > 0; level++ {
+		p.order = append(p.order, frontier...)
+		if !p.options.expands(level) {
+			break
+		}
+
+		scout := 0
+		for i, v := range frontier {
+			p.position[v] = i
+			scout += len(p.out[v])
+		}
+
+		switch {
+		case !bottomUp && scout > unexplored/p.alpha:
+			bottomUp = true
+		case bottomUp && len(frontier) < len(p.labels)/p.beta:
+			bottomUp = false
+		}
+
+		var claims []bfsClaim
+		if bottomUp {
+			claims = p.bottomUp(level)
+		} else {
+			claims = p.topDown(frontier)
+		}
+
+		next := make([]int, len(claims))
+		for i, c := range claims {
+			next[i] = c.vertex
+			p.depth[c.vertex] = level + 1
+			p.parent[c.vertex] = frontier[c.key>>32]
+			unexplored -= len(p.in[c.vertex])
+		}
+
+		frontier = next
+	}
+}
+
+// topDown expands the edges of the frontier. The workers claim each
+// unvisited neighbor with an atomic minimum of the parent keys, and the
+// claims that keep the minimum are the next level, in the key order.
+func (p *parallelBFS[T]) topDown(frontier []int) []bfsClaim {
+	chunks := p.parallel(len(frontier), nil)
+	found := make([][]bfsClaim, chunks)
+	p.parallel(len(frontier), func(chunk, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			for rank, w := range p.out[frontier[i]] {
+				if p.depth[w] >= 0 || !p.allowed[w] {
+					continue
+				}
+
+				key := int64(i)<<32 | int64(rank)
+				for {
+					old := atomic.LoadInt64(&p.key[w])
+					if key >= old {
+						break
+					}
+
+					if atomic.CompareAndSwapInt64(&p.key[w], old, key) {
+						found[chunk] = append(found[chunk], bfsClaim{vertex: w, key: key})
+						break
+					}
+				}
+			}
+		}
+	})
+
+	// the chunks are in the key order, and so are the claims of a chunk.
+	claims := make([]bfsClaim, 0)
+	for _, chunk := range found {
+		for _, c := range chunk {
+			if p.key[c.vertex] == c.key {
+				claims = append(claims, c)
+				p.key[c.vertex] = noClaim
+			}
+		}
+	}
+
+	return claims
+}
+
+// bottomUp checks the edges to each unvisited vertex for a parent in the
+// frontier, which is the level of the specified depth, and keeps the
+// parent with the smallest key.
+func (p *parallelBFS[T]) bottomUp(level int) []bfsClaim {
+	chunks := p.parallel(len(p.labels), nil)
+	found := make([][]bfsClaim, chunks)
+	p.parallel(len(p.labels), func(chunk, lo, hi int) {
+		for v := lo; v < hi; v++ {
+			if p.depth[v] >= 0 || !p.allowed[v] {
+				continue
+			}
+
+			best := int64(noClaim)
+			for _, e := range p.in[v] {
+				if p.depth[e.from] != level {
+					continue
+				}
+
+				if key := int64(p.position[e.from])<<32 | int64(e.rank); key < best {
+					best = key
+				}
+			}
+
+			if best != noClaim {
+				found[chunk] = append(found[chunk], bfsClaim{vertex: v, key: best})
+			}
+		}
+	})
+
+	claims := make([]bfsClaim, 0)
+	for _, chunk := range found {
+		claims = append(claims, chunk...)
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].key < claims[j].key
+	})
+
+	return claims
+}
+
+// parallel splits the range [0, n) into chunks, and calls f for each
+// chunk on the worker goroutines. It returns the number of chunks, and
+// only counts them if f is nil.
+func (p *parallelBFS[T]) parallel(n int, f func(chunk, lo, hi int)) int {
+	// more chunks than workers balance the uneven degrees.
+	chunks := min(n, p.workers*4)
+	if f == nil || chunks == 0 {
+		return chunks
+	}
+
+	size := (n + chunks - 1) / chunks
+	var next int64 = -1
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(p.workers, chunks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				chunk := int(atomic.AddInt64(&next, 1))
+				if chunk >= chunks {
+					return
+				}
+
+				f(chunk, chunk*size, min((chunk+1)*size, n))
+			}
+		}()
+	}
+
+	wg.Wait()
+	return chunks
+}
+
+// result converts the visited vertices to a SearchResult.
+func (p *parallelBFS[T]) result(g gograph.Graph[T], start T) *SearchResult[T] {
+	result := &SearchResult[T]{
+		graph:  g,
+		start:  start,
+		order:  make([]T, len(p.order)),
+		depth:  make(map[T]int, len(p.order)),
+		parent: make(map[T]T, len(p.order)),
+		source: make(map[T]T, len(p.order)),
+	}
+
+	for i, v := range p.order {
+		label := p.labels[v]
+		result.order[i] = label
+		result.depth[label] = p.depth[v]
+		result.source[label] = start
+		if p.depth[v] > 0 {
+			result.parent[label] = p.labels[p.parent[v]]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,103 @@
+package traverse
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func newRandomGraph(directed bool, n, m int, seed int64) gograph.Graph[int] {
+	var g gograph.Graph[int]
+	if directed {
+		g = gograph.New[int](gograph.Directed())
+	} else {
+		g = gograph.New[int]()
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < n; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < m; i++ {
+		_, _ = g.AddEdge(g.GetVertexByID(r.Intn(n)), g.GetVertexByID(r.Intn(n)))
+	}
+
+	return g
+}
+
+func TestParallelBreadthFirstSearch(t *testing.T) {
+	filter := WithVisitFilter(func(v *gograph.Vertex[int]) bool { return v.Label()%7 != 3 })
+
+	optionSets := map[string][]OptionFunc[int]{
+		"default":   nil,
+		"in":        {WithDirection[int](In)},
+		"both":      {WithDirection[int](Both)},
+		"filter":    {filter},
+		"max depth": {WithMaxDepth[int](2)},
+	}
+
+	// alpha and beta force top-down, bottom-up, and the switching steps.
+	modes := [][2]int{{bfsAlpha, bfsBeta}, {1, 1}, {1 << 30, 1 << 30}}
+
+	for seed := int64(0); seed < 4; seed++ {
+		for _, directed := range []bool{true, false} {
+			g := newRandomGraph(directed, 300, 900, seed)
+			for name, options := range optionSets {
+				expected, _ := BreadthFirstSearch(g, 0, options...)
+
+				for _, workers := range []int{1, 3, 8} {
+					for _, mode := range modes {
+						p := newParallelBFS(g, newOptions(append(options, WithParallelism[int](workers))...))
+						p.alpha, p.beta = mode[0], mode[1]
+						p.run(p.index[0])
+						result := p.result(g, 0)
+
+						if !reflect.DeepEqual(expected.order, result.order) ||
+							!reflect.DeepEqual(expected.depth, result.depth) ||
+							!reflect.DeepEqual(expected.parent, result.parent) {
+							t.Fatalf("seed %d, directed %t, %s, %d workers, mode %v: expected the sequential result",
+								seed, directed, name, workers, mode)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestParallelBreadthFirstSearch_Result(t *testing.T) {
+	g := newSearchGraph()
+
+	result, err := ParallelBreadthFirstSearch(g, "A", WithParallelism[string](2))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual([]string{"A", "B", "D", "C", "E", "F"}, result.Order()) {
+		t.Errorf("Expected the BFS order, but got %v", result.Order())
+	}
+
+	if depth, _ := result.Depth("F"); depth != 3 {
+		t.Errorf("Expected depth 3, but got %d", depth)
+	}
+
+	if parent, _ := result.Parent("E"); parent != "B" {
+		t.Errorf("Expected parent B, but got %s", parent)
+	}
+
+	if source, _ := result.Source("F"); source != "A" {
+		t.Errorf("Expected source A, but got %s", source)
+	}
+
+	if tree := result.Tree(); tree.Size() != 5 {
+		t.Errorf("Expected 5 tree edges, but got %d", tree.Size())
+	}
+
+	if _, err = ParallelBreadthFirstSearch(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+}
@@ -0,0 +1,81 @@
+package traverse
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
+)
+
+// RandomWalks generates a corpus of random walks, such as the input of
+// the DeepWalk and node2vec embeddings. It starts walksPerVertex walks
+// from every vertex, and each walk contains at most walkLength vertex
+// labels. The walks are ordered by rounds: the first walk of each vertex
+// in the label order, then the second walk of each vertex, and so on.
+//
+// The walks are generated by the worker goroutines that are set by
+// WithWalkParallelism, and they accept the same options as
+// NewRandomWalkIterator. Each walk has its own source of randomness,
+// which is seeded from the source of WithRandSource, so the corpus is
+// reproducible regardless of the number of workers.
+//
+// It returns ErrInvalidWalkOptions if the options are out of their ranges.
+func RandomWalks[T comparable](
+	g gograph.Graph[T],
+	walksPerVertex, walkLength int,
+	options ...WalkOptionFunc,
+) ([][]T, error) {
+	opts, err := newWalkOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	vertices := util.SortedVertices(g)
+	if walksPerVertex <= 0 || len(vertices) == 0 {
+		return [][]T{}, nil
+	}
+
+	// the seeds are drawn in order, before the walks run concurrently.
+	random := rand.New(opts.source())
+	seeds := make([]int64, walksPerVertex*len(vertices))
+	for i := range seeds {
+		seeds[i] = random.Int63()
+	}
+
+	// the incoming neighbors are collected once, and shared by the walks.
+	adj := newAdjacency(g, opts.direction)
+	adj.load()
+
+	walks := make([][]T, len(seeds))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < opts.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				start := vertices[i%len(vertices)].Label()
+				iter := newRandomWalkIterator(g, start, walkLength, opts, adj, rand.New(rand.NewSource(seeds[i])))
+				iter.vertices = vertices
+
+				walk := make([]T, 0, walkLength)
+				for iter.HasNext() {
+					walk = append(walk, iter.Next().Label())
+				}
+
+				walks[i] = walk
+			}
+		}()
+	}
+
+	for i := range seeds {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return walks, nil
+}
@@ -0,0 +1,48 @@
+package traverse
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestRandomWalks(t *testing.T) {
+	g := gograph.New[string]()
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}, {"C", "D"}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	walks, err := RandomWalks(g, 3, 8, WithRandSource(rand.NewSource(5)), WithWalkParallelism(4))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(walks) != 12 {
+		t.Fatalf("Expected 12 walks, but got %d", len(walks))
+	}
+
+	starts := []string{"A", "B", "C", "D"}
+	for i, walk := range walks {
+		if len(walk) != 8 || walk[0] != starts[i%4] {
+			t.Errorf("Expected walk %d of 8 vertices from %s, but got %v", i, starts[i%4], walk)
+		}
+
+		for j := 1; j < len(walk); j++ {
+			if !g.ContainsEdge(g.GetVertexByID(walk[j-1]), g.GetVertexByID(walk[j])) {
+				t.Errorf("Expected edge %s - %s in walk %v", walk[j-1], walk[j], walk)
+			}
+		}
+	}
+
+	// the corpus doesn't depend on the number of workers.
+	sequential, _ := RandomWalks(g, 3, 8, WithRandSource(rand.NewSource(5)), WithWalkParallelism(1))
+	if !reflect.DeepEqual(walks, sequential) {
+		t.Error("Expected the same corpus for the same seed")
+	}
+
+	if walks, _ = RandomWalks(g, 0, 8); len(walks) != 0 {
+		t.Errorf("Expected no walks, but got %v", walks)
+	}
+}
@@ -1,10 +1,10 @@
 package traverse
 
 import (
-	"crypto/rand"
-	"math/big"
+	"math/rand"
 
 	"github.com/hmdsefi/gograph"
+	"github.com/hmdsefi/gograph/util"
 )
 
 // randomWalkIterator implements the Iterator interface to travers
@@ -23,35 +23,75 @@ import (
 // connecting the current node and the neighbor. This means that nodes
 // connected by heavier edges are more likely to be visited during the
 // traversal.
+//
+// The walk options add restarts to the start vertex, teleports at the
+// dead ends, and the node2vec bias towards or away from the previous
+// vertex.
 type randomWalkIterator[T comparable] struct {
-	graph       gograph.Graph[T]   // the graph that being traversed.
-	start       T                  // the label of starting point of the traversal.
-	current     *gograph.Vertex[T] // the latest node that has been returned by the iterator.
-	steps       int                // the maximum number of steps to be taken during the traversal.
-	currentStep int                // the step counter.
+	graph       gograph.Graph[T]     // the graph that being traversed.
+	start       T                    // the label of starting point of the traversal.
+	current     *gograph.Vertex[T]   // the latest node that has been returned by the iterator.
+	previous    *gograph.Vertex[T]   // the node before the current one, if the walker moved along an edge.
+	steps       int                  // the maximum number of steps to be taken during the traversal.
+	currentStep int                  // the step counter.
+	options     *WalkOptions         // the walk options.
+	rand        *rand.Rand           // the source of randomness of the walk.
+	vertices    []*gograph.Vertex[T] // the teleport destinations, in the label order.
+	adjacency   *adjacency[T]        // the neighbors in the direction of the walk.
 }
 
 // NewRandomWalkIterator creates a new instance of randomWalkIterator
-// and returns it as the Iterator interface.
-func NewRandomWalkIterator[T comparable](graph gograph.Graph[T], start T, steps int) (Iterator[T], error) {
+// and returns it as the Iterator interface. The walk returns at most
+// the specified number of vertices, starting with the start vertex.
+//
+// By default, the walk stops at the vertices without neighbors to move
+// to, which are the vertices without outgoing edges in the Out direction,
+// and the randomness is seeded randomly. The walk options, such as
+// WithRandSource and WithRestart, change this behavior. It returns
+// ErrInvalidWalkOptions if the options are out of their ranges.
+func NewRandomWalkIterator[T comparable](
+	graph gograph.Graph[T],
+	start T,
+	steps int,
+	options ...WalkOptionFunc,
+) (Iterator[T], error) {
 	v := graph.GetVertexByID(start)
 	if v == nil {
 		return nil, gograph.ErrVertexDoesNotExist
 	}
 
+	opts, err := newWalkOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRandomWalkIterator(graph, start, steps, opts, newAdjacency(graph, opts.direction), rand.New(opts.source())), nil
+}
+
+func newRandomWalkIterator[T comparable](
+	graph gograph.Graph[T],
+	start T,
+	steps int,
+	options *WalkOptions,
+	adjacency *adjacency[T],
+	random *rand.Rand,
+) *randomWalkIterator[T] {
 	return &randomWalkIterator[T]{
-		graph:   graph,
-		start:   start,
-		current: v,
-		steps:   steps,
-	}, nil
+		graph:     graph,
+		start:     start,
+		current:   graph.GetVertexByID(start),
+		steps:     steps,
+		options:   options,
+		rand:      random,
+		adjacency: adjacency,
+	}
 }
 
 // HasNext returns a boolean indicating whether there are more vertices
 // to be visited or not.
 func (r *randomWalkIterator[T]) HasNext() bool {
 	return r.current != nil &&
-		r.current.OutDegree() > 0 &&
+		(r.options.teleport || r.options.restart > 0 || len(r.adjacency.neighbors(r.current)) > 0) &&
 		r.currentStep < r.steps
 }
 
@@ -70,16 +110,20 @@ func (r *randomWalkIterator[T]) Next() *gograph.Vertex[T] {
 	}
 
 	r.currentStep++
-	neighbors := r.current.Neighbors()
 
-	if r.graph.IsWeighted() {
-		r.current = r.randomVertex(r.current)
-		return r.current
+	neighbors := r.adjacency.neighbors(r.current)
+	switch {
+	case r.options.restart > 0 && r.rand.Float64() < r.options.restart:
+		r.previous, r.current = nil, r.graph.GetVertexByID(r.start)
+	case len(neighbors) > 0:
+		r.previous, r.current = r.current, r.randomVertex(r.current, neighbors)
+	case r.options.teleport:
+		r.previous, r.current = nil, r.teleport()
+	default:
+		// a dead end of a walk with restart
+		r.previous, r.current = nil, r.graph.GetVertexByID(r.start)
 	}
 
-	i, _ := rand.Int(rand.Reader, big.NewInt(int64(len(neighbors))))
-	r.current = neighbors[i.Int64()]
-
 	return r.current
 }
 
@@ -97,39 +141,73 @@ func (r *randomWalkIterator[T]) Iterate(f func(v *gograph.Vertex[T]) error) erro
 }
 
 // Reset resets the iterator by setting the initial state of the iterator.
+// The walk starts again from the start vertex, and the source of
+// randomness continues its sequence.
 func (r *randomWalkIterator[T]) Reset() {
 	r.current = r.graph.GetVertexByID(r.start)
+	r.previous = nil
 	r.currentStep = 0
+	r.adjacency.reset()
 }
 
-func (r *randomWalkIterator[T]) randomVertex(v *gograph.Vertex[T]) *gograph.Vertex[T] {
-	if v == nil {
-		return nil
-	}
+// randomVertex chooses the next vertex among the neighbors of v. The
+// probability of each neighbor is proportional to the edge weight in
+// weighted graphs, and it is multiplied by the node2vec bias, which
+// depends on the distance between the neighbor and the previous vertex:
+// 1/p for the previous vertex itself, 1 for its neighbors, and 1/q for
+// the others.
+func (r *randomWalkIterator[T]) randomVertex(v *gograph.Vertex[T], neighbors []T) *gograph.Vertex[T] {
+	biased := r.previous != nil && (r.options.p != 1 || r.options.q != 1)
 
+	// calculate the unnormalized probabilities and their sum
 	var totalWeight float64
-	var edges []*gograph.Edge[T]
-	neighbors := v.Neighbors()
-
-	// calculate the sum of edge weights
-	for _, neighbor := range neighbors {
-		if edge := r.graph.GetEdge(v, neighbor); edge != nil {
-			edges = append(edges, edge)
-			totalWeight += edge.Weight()
+	weights := make([]float64, len(neighbors))
+	for i, label := range neighbors {
+		neighbor := r.graph.GetVertexByID(label)
+
+		weight := 1.0
+		if r.graph.IsWeighted() {
+			weight = 0
+			if edge := r.adjacency.edge(v, neighbor); edge != nil && edge.Weight() > 0 {
+				weight = edge.Weight()
+			}
+		}
+
+		if biased {
+			switch {
+			case label == r.previous.Label():
+				weight /= r.options.p
+			case r.adjacency.edge(r.previous, neighbor) == nil:
+				weight /= r.options.q
+			}
 		}
+
+		weights[i] = weight
+		totalWeight += weight
 	}
 
-	// generate a random number between 0 and the sum of edge weights
-	randNum, _ := rand.Int(rand.Reader, big.NewInt(int64(totalWeight)))
-	randWeight := float64(randNum.Int64())
+	// without positive weights, each neighbor has an equal chance
+	if totalWeight <= 0 {
+		return r.graph.GetVertexByID(neighbors[r.rand.Intn(len(neighbors))])
+	}
 
-	// find the vertex that corresponds to the random weight
-	for _, edge := range edges {
-		randWeight -= edge.Weight()
+	// find the vertex that corresponds to a random weight
+	randWeight := r.rand.Float64() * totalWeight
+	for i, weight := range weights {
+		randWeight -= weight
 		if randWeight < 0 {
-			return edge.OtherVertex(v.Label())
+			return r.graph.GetVertexByID(neighbors[i])
 		}
 	}
 
-	return nil
+	return r.graph.GetVertexByID(neighbors[len(neighbors)-1])
+}
+
+// teleport chooses a uniformly random vertex of the graph.
+func (r *randomWalkIterator[T]) teleport() *gograph.Vertex[T] {
+	if r.vertices == nil {
+		r.vertices = util.SortedVertices(r.graph)
+	}
+
+	return r.vertices[r.rand.Intn(len(r.vertices))]
 }
@@ -2,6 +2,8 @@ package traverse
 
 import (
 	"errors"
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/hmdsefi/gograph"
@@ -162,3 +164,169 @@ func TestRandomWalkIterator_RandomVertex(t *testing.T) {
 		t.Errorf("Random vertex %v is outside the range of valid vertices 2,3", randV.Label())
 	}
 }
+
+func walkLabels(t *testing.T, it Iterator[int]) []int {
+	t.Helper()
+
+	var labels []int
+	_ = it.Iterate(func(v *gograph.Vertex[int]) error {
+		labels = append(labels, v.Label())
+		return nil
+	})
+
+	return labels
+}
+
+func TestRandomWalkIterator_RandSource(t *testing.T) {
+	g := gograph.New[int](gograph.Weighted())
+	for i := 1; i <= 5; i++ {
+		for j := 1; j <= 5; j++ {
+			if i != j {
+				_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(j), gograph.WithEdgeWeight(0.5*float64(i+j)))
+			}
+		}
+	}
+
+	first, _ := NewRandomWalkIterator(g, 1, 50, WithRandSource(rand.NewSource(42)))
+	second, _ := NewRandomWalkIterator(g, 1, 50, WithRandSource(rand.NewSource(42)))
+
+	walk := walkLabels(t, first)
+	if len(walk) != 50 {
+		t.Fatalf("Expected 50 vertices, but got %d", len(walk))
+	}
+
+	if !reflect.DeepEqual(walk, walkLabels(t, second)) {
+		t.Error("Expected the same walks for the same seed")
+	}
+}
+
+func TestRandomWalkIterator_DeadEnd(t *testing.T) {
+	// 1 -> 2, where 2 is a dead end, and the isolated vertex 3.
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	g.AddVertexByLabel(3)
+
+	it, _ := NewRandomWalkIterator(g, 1, 10, WithRandSource(rand.NewSource(1)))
+	if walk := walkLabels(t, it); !reflect.DeepEqual([]int{1, 2}, walk) {
+		t.Errorf("Expected the walk to stop at the dead end, but got %v", walk)
+	}
+
+	it, _ = NewRandomWalkIterator(g, 1, 10, WithRandSource(rand.NewSource(1)), WithRestart(0))
+	if walk := walkLabels(t, it); len(walk) != 2 {
+		t.Errorf("Expected the walk to stop at the dead end, but got %v", walk)
+	}
+
+	// without teleport, a walk with restart goes back to the start.
+	it, _ = NewRandomWalkIterator(g, 1, 6, WithRandSource(rand.NewSource(1)), WithRestart(0.01))
+	if walk := walkLabels(t, it); !reflect.DeepEqual([]int{1, 2, 1, 2, 1, 2}, walk) {
+		t.Errorf("Expected the walk to restart at the dead end, but got %v", walk)
+	}
+
+	it, _ = NewRandomWalkIterator(g, 1, 100, WithRandSource(rand.NewSource(1)), WithTeleport())
+	walk := walkLabels(t, it)
+	if len(walk) != 100 {
+		t.Fatalf("Expected the walk to teleport at the dead ends, but got %v", walk)
+	}
+
+	teleported := false
+	for i := 1; i < len(walk); i++ {
+		if walk[i] == 3 {
+			teleported = true
+		}
+
+		if walk[i-1] == 1 && walk[i] != 2 {
+			t.Errorf("Expected the walker to follow the edge 1 -> 2, but got %d -> %d", walk[i-1], walk[i])
+		}
+	}
+
+	if !teleported {
+		t.Errorf("Expected the walk to teleport to the isolated vertex, but got %v", walk)
+	}
+}
+
+func TestRandomWalkIterator_Restart(t *testing.T) {
+	// a long path 0 - 1 - ... - 99.
+	g := gograph.New[int]()
+	for i := 0; i < 99; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+1))
+	}
+
+	it, _ := NewRandomWalkIterator(g, 0, 1000, WithRandSource(rand.NewSource(7)), WithRestart(0.5))
+	restarts := 0
+	walk := walkLabels(t, it)
+	for i := 1; i < len(walk); i++ {
+		if walk[i] == 0 && walk[i-1] != 1 {
+			restarts++
+		}
+	}
+
+	if restarts < 300 || restarts > 700 {
+		t.Errorf("Expected about 500 restarts, but got %d", restarts)
+	}
+}
+
+func TestRandomWalkIterator_Node2Vec(t *testing.T) {
+	// a star with the center 0, where every move away from the center
+	// returns to it, and a move from the center returns to the previous
+	// leaf with the weight 1/p.
+	g := gograph.New[int]()
+	for i := 1; i <= 4; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(0), gograph.NewVertex(i))
+	}
+
+	count := func(p, q float64) int {
+		it, _ := NewRandomWalkIterator(g, 1, 2001, WithRandSource(rand.NewSource(3)), WithNode2Vec(p, q))
+		walk := walkLabels(t, it)
+
+		returns := 0
+		for i := 2; i < len(walk); i += 2 {
+			if walk[i] == walk[i-2] {
+				returns++
+			}
+		}
+
+		return returns
+	}
+
+	// 1000 moves from the center: 1/4 chance to return with p = q = 1,
+	// and almost never with a high p.
+	if returns := count(1, 1); returns < 150 || returns > 350 {
+		t.Errorf("Expected about 250 returns, but got %d", returns)
+	}
+
+	if returns := count(1000, 1); returns > 10 {
+		t.Errorf("Expected almost no returns with a high p, but got %d", returns)
+	}
+
+	if returns := count(0.001, 1); returns < 990 {
+		t.Errorf("Expected almost only returns with a low p, but got %d", returns)
+	}
+}
+
+func TestRandomWalkIterator_Direction(t *testing.T) {
+	g := newSearchGraph()
+
+	for seed := int64(0); seed < 10; seed++ {
+		it, _ := NewRandomWalkIterator(g, "F", 10, WithRandSource(rand.NewSource(seed)), WithWalkDirection(In))
+
+		var walk []string
+		_ = it.Iterate(func(v *gograph.Vertex[string]) error {
+			walk = append(walk, v.Label())
+			return nil
+		})
+
+		if len(walk) != 4 || walk[len(walk)-1] != "A" {
+			t.Fatalf("Expected the walk to end at the source A, but got %v", walk)
+		}
+
+		for i := 1; i < len(walk); i++ {
+			if !g.ContainsEdge(g.GetVertexByID(walk[i]), g.GetVertexByID(walk[i-1])) {
+				t.Errorf("Expected edge %s -> %s in walk %v", walk[i], walk[i-1], walk)
+			}
+		}
+	}
+
+	if _, err := NewRandomWalkIterator(g, "F", 10, WithWalkDirection(Direction(5))); !errors.Is(err, ErrInvalidWalkOptions) {
+		t.Errorf("Expected error %s, but got %v", ErrInvalidWalkOptions, err)
+	}
+}
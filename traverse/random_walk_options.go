@@ -0,0 +1,120 @@
+package traverse
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	mrand "math/rand"
+	"runtime"
+)
+
+var ErrInvalidWalkOptions = errors.New("invalid random walk options")
+
+// WalkOptionFunc represent an alias of function type that
+// modifies the specified random walk options.
+type WalkOptionFunc func(options *WalkOptions)
+
+// WalkOptions represents the options of the random walks.
+type WalkOptions struct {
+	randSource mrand.Source
+	restart    float64
+	teleport   bool
+	p          float64
+	q          float64
+	workers    int
+	direction  Direction
+}
+
+// WithRandSource sets the source of randomness of the walk, so the walks
+// can be reproduced with a seeded source, e.g. rand.NewSource(42). By
+// default, each walk uses a randomly seeded source.
+//
+// A rand.Source is not safe for concurrent use, so a source shouldn't be
+// shared between the iterators that run in different goroutines.
+func WithRandSource(source mrand.Source) WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.randSource = source
+	}
+}
+
+// WithRestart sets the probability, between 0 and 1, that the walker
+// jumps back to the start vertex at each step, instead of moving to a
+// neighbor. It is the random walk with restart, which is used for
+// personalized PageRank and proximity scores. At the vertices without
+// neighbors to move to, the walker always restarts, unless WithTeleport
+// is set.
+func WithRestart(probability float64) WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.restart = probability
+	}
+}
+
+// WithTeleport makes the walker jump to a uniformly random vertex of the
+// graph, when it reaches a vertex without neighbors to move to. By default,
+// the walk stops at such vertices.
+func WithTeleport() WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.teleport = true
+	}
+}
+
+// WithNode2Vec sets the return parameter p and the in-out parameter q of
+// the node2vec second-order walk. A high p makes the walker less likely
+// to return to the previous vertex, and a low q makes it explore outward
+// like DFS, while a high q keeps it local like BFS. Both parameters must
+// be positive, and the default p = q = 1 is the first-order walk.
+func WithNode2Vec(p, q float64) WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.p = p
+		options.q = q
+	}
+}
+
+// WithWalkParallelism sets the number of goroutines that generate the
+// walks of RandomWalks, like WithParallelism does for the parallel
+// traversals. The default is runtime.GOMAXPROCS(0).
+func WithWalkParallelism(workers int) WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.workers = workers
+	}
+}
+
+// WithWalkDirection sets the direction of the edges that the walker
+// follows, e.g. In walks backwards along the incoming edges. The edge
+// weights and the node2vec bias apply in the same direction.
+func WithWalkDirection(direction Direction) WalkOptionFunc {
+	return func(options *WalkOptions) {
+		options.direction = direction
+	}
+}
+
+func newWalkOptions(options ...WalkOptionFunc) (*WalkOptions, error) {
+	opts := &WalkOptions{
+		p:       1,
+		q:       1,
+		workers: runtime.GOMAXPROCS(0),
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	if opts.restart < 0 || opts.restart > 1 || opts.p <= 0 || opts.q <= 0 || opts.workers < 1 ||
+		opts.direction < Out || opts.direction > Both {
+		return nil, ErrInvalidWalkOptions
+	}
+
+	return opts, nil
+}
+
+// source returns the source of randomness of the options, or a randomly
+// seeded one.
+func (o *WalkOptions) source() mrand.Source {
+	if o.randSource != nil {
+		return o.randSource
+	}
+
+	var seed [8]byte
+	_, _ = rand.Read(seed[:])
+	return mrand.NewSource(int64(binary.LittleEndian.Uint64(seed[:])))
+}
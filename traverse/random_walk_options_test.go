@@ -0,0 +1,31 @@
+package traverse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+func TestNewWalkOptions_Invalid(t *testing.T) {
+	g := gograph.New[int]()
+	g.AddVertexByLabel(1)
+
+	invalid := [][]WalkOptionFunc{
+		{WithRestart(-0.1)},
+		{WithRestart(1.5)},
+		{WithNode2Vec(0, 1)},
+		{WithNode2Vec(1, -1)},
+		{WithWalkParallelism(0)},
+	}
+
+	for i, options := range invalid {
+		if _, err := NewRandomWalkIterator(g, 1, 10, options...); !errors.Is(err, ErrInvalidWalkOptions) {
+			t.Errorf("%d: expected error %s, but got %v", i, ErrInvalidWalkOptions, err)
+		}
+
+		if _, err := RandomWalks(g, 1, 10, options...); !errors.Is(err, ErrInvalidWalkOptions) {
+			t.Errorf("%d: expected error %s from RandomWalks, but got %v", i, ErrInvalidWalkOptions, err)
+		}
+	}
+}
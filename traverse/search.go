@@ -0,0 +1,257 @@
+package traverse
+
+import (
+	"errors"
+
+	"github.com/hmdsefi/gograph"
+)
+
+var ErrNoSource = errors.New("at least one source vertex is required")
+
+// SearchResult is the outcome of a breadth-first or depth-first search.
+// It contains the discovery order of the visited vertices, and the depth
+// and the parent of each vertex in the traversal tree.
+type SearchResult[T comparable] struct {
+	graph  gograph.Graph[T]
+	start  T
+	order  []T
+	depth  map[T]int
+	parent map[T]T
+	source map[T]T
+}
+
+// BreadthFirstSearch traverses the graph from the start vertex in the
+// same order as the BFS iterator, and returns the traversal tree. In
+// the BFS tree, the depth of each vertex is its distance in edges from
+// the start vertex.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func BreadthFirstSearch[T comparable](g gograph.Graph[T], start T, options ...OptionFunc[T]) (*SearchResult[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	iter := newBreadthFirstIterator(g, []T{start}, newOptions(options...))
+	result := newSearchResult(g, start, iter, iter.depth, iter.parent)
+	result.source = iter.source
+	return result, nil
+}
+
+// MultiSourceBreadthFirstSearch traverses the graph from all the sources
+// at once, in the same order as the multi-source BFS iterator. The depth
+// of each vertex is its distance in edges to the nearest source, and
+// Source returns that source, which answers the nearest facility queries.
+// When more sources are at the same distance, the first source in the
+// specified order that reaches the vertex wins.
+//
+// It returns ErrNoSource if no source is specified, and
+// gograph.ErrVertexDoesNotExist if any of the sources doesn't exist in
+// the graph.
+func MultiSourceBreadthFirstSearch[T comparable](
+	g gograph.Graph[T],
+	sources []T,
+	options ...OptionFunc[T],
+) (*SearchResult[T], error) {
+	if len(sources) == 0 {
+		return nil, ErrNoSource
+	}
+
+	for _, source := range sources {
+		if g.GetVertexByID(source) == nil {
+			return nil, gograph.ErrVertexDoesNotExist
+		}
+	}
+
+	iter := newBreadthFirstIterator(g, sources, newOptions(options...))
+	result := newSearchResult(g, sources[0], iter, iter.depth, iter.parent)
+	result.source = iter.source
+	return result, nil
+}
+
+// DepthFirstSearch traverses the graph from the start vertex in the same
+// order as the DFS iterator, and returns the traversal tree.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func DepthFirstSearch[T comparable](g gograph.Graph[T], start T, options ...OptionFunc[T]) (*SearchResult[T], error) {
+	if g.GetVertexByID(start) == nil {
+		return nil, gograph.ErrVertexDoesNotExist
+	}
+
+	iter := newDepthFirstIterator(g, start, newOptions(options...))
+	return newSearchResult(g, start, iter, iter.depth, iter.parent), nil
+}
+
+// newSearchResult exhausts the iterator, which fills the depth and the
+// parent maps.
+func newSearchResult[T comparable](
+	g gograph.Graph[T],
+	start T,
+	iter Iterator[T],
+	depth map[T]int,
+	parent map[T]T,
+) *SearchResult[T] {
+	result := &SearchResult[T]{
+		graph:  g,
+		start:  start,
+		depth:  depth,
+		parent: parent,
+	}
+
+	for iter.HasNext() {
+		result.order = append(result.order, iter.Next().Label())
+	}
+
+	return result
+}
+
+// Start returns the label of the start vertex, or the first source of
+// a multi-source search.
+func (r *SearchResult[T]) Start() T {
+	return r.start
+}
+
+// Order returns the labels of the visited vertices in the discovery order.
+func (r *SearchResult[T]) Order() []T {
+	return append([]T(nil), r.order...)
+}
+
+// Visited reports whether the vertex was visited by the search.
+func (r *SearchResult[T]) Visited(label T) bool {
+	_, ok := r.depth[label]
+	return ok
+}
+
+// Depth returns the number of edges between the start vertex and the
+// specified vertex in the traversal tree. The second value is false if
+// the vertex was not visited.
+func (r *SearchResult[T]) Depth(label T) (int, bool) {
+	depth, ok := r.depth[label]
+	return depth, ok
+}
+
+// Parent returns the label of the vertex that discovered the specified
+// vertex. The second value is false for the start vertex and the vertices
+// that were not visited.
+func (r *SearchResult[T]) Parent(label T) (T, bool) {
+	parent, ok := r.parent[label]
+	return parent, ok
+}
+
+// Source returns the label of the source that reached the specified
+// vertex in a breadth-first search. For a single start vertex, it is the
+// start vertex. The second value is false if the vertex was not visited,
+// or the result is of a depth-first search.
+func (r *SearchResult[T]) Source(label T) (T, bool) {
+	source, ok := r.source[label]
+	return source, ok
+}
+
+// AtDepth returns the labels of the visited vertices at the specified
+// depth, in the discovery order.
+func (r *SearchResult[T]) AtDepth(depth int) []T {
+	var labels []T
+	for _, label := range r.order {
+		if r.depth[label] == depth {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
+// Tree returns the traversal tree as a new graph, which contains the
+// visited vertices and the edges between each parent and its children.
+// The edges keep their direction, so in a traversal along the incoming
+// edges, they point from the children to their parent. The tree has the
+// same directed and weighted properties as the traversed graph, and the
+// vertices and edges keep their weights and metadata.
+func (r *SearchResult[T]) Tree() gograph.Graph[T] {
+	tree := newGraphLike(r.graph)
+	for _, label := range r.order {
+		copyVertex(tree, r.graph.GetVertexByID(label))
+	}
+
+	for _, label := range r.order {
+		parent, ok := r.parent[label]
+		if !ok {
+			continue
+		}
+
+		from, to := r.graph.GetVertexByID(parent), r.graph.GetVertexByID(label)
+		if edge := r.graph.GetEdge(from, to); edge != nil {
+			copyEdge(tree, edge)
+		} else {
+			copyEdge(tree, r.graph.GetEdge(to, from))
+		}
+	}
+
+	return tree
+}
+
+// KHopNeighborhood returns the subgraph induced by the vertices that are
+// at most k edges away from the start vertex, i.e. these vertices and all
+// the edges between them. The options, such as WithVisitFilter, limit the
+// vertices of the neighborhood further.
+//
+// It returns gograph.ErrVertexDoesNotExist if the start vertex doesn't
+// exist in the graph.
+func KHopNeighborhood[T comparable](g gograph.Graph[T], start T, k int, options ...OptionFunc[T]) (gograph.Graph[T], error) {
+	result, err := BreadthFirstSearch(g, start, append(options[:len(options):len(options)], WithMaxDepth[T](k))...)
+	if err != nil {
+		return nil, err
+	}
+
+	subgraph := newGraphLike(g)
+	for _, label := range result.order {
+		copyVertex(subgraph, g.GetVertexByID(label))
+	}
+
+	for _, label := range result.order {
+		v := g.GetVertexByID(label)
+		for _, neighbor := range v.Neighbors() {
+			if !result.Visited(neighbor.Label()) {
+				continue
+			}
+
+			copyEdge(subgraph, g.GetEdge(v, neighbor))
+		}
+	}
+
+	return subgraph, nil
+}
+
+// newGraphLike creates an empty graph with the same directed and weighted
+// properties as the specified graph.
+func newGraphLike[T comparable](g gograph.Graph[T]) gograph.Graph[T] {
+	var options []gograph.GraphOptionFunc
+	if g.IsDirected() {
+		options = append(options, gograph.Directed())
+	}
+
+	if g.IsWeighted() {
+		options = append(options, gograph.Weighted())
+	}
+
+	return gograph.New[T](options...)
+}
+
+func copyVertex[T comparable](dst gograph.Graph[T], v *gograph.Vertex[T]) {
+	dst.AddVertexByLabel(v.Label(), gograph.WithVertexWeight(v.Weight()), gograph.WithVertexMetadata(v.Metadata()))
+}
+
+// copyEdge adds the edge between the copies of its endpoints, unless it
+// already exists.
+func copyEdge[T comparable](dst gograph.Graph[T], e *gograph.Edge[T]) {
+	if e == nil {
+		return
+	}
+
+	source, destination := dst.GetVertexByID(e.Source().Label()), dst.GetVertexByID(e.Destination().Label())
+	if dst.ContainsEdge(source, destination) {
+		return
+	}
+
+	_, _ = dst.AddEdge(source, destination, gograph.WithEdgeWeight(e.Weight()), gograph.WithEdgeMetadata(e.Metadata()))
+}
@@ -0,0 +1,255 @@
+package traverse
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// newSearchGraph returns the example graph
+//
+//	A -> B -> C
+//	|    |
+//	v    v
+//	D -> E -> F
+//
+// where the edge weights are the positions of the edges.
+func newSearchGraph() gograph.Graph[string] {
+	g := gograph.New[string](gograph.Directed(), gograph.Weighted())
+	edges := [][2]string{{"A", "B"}, {"A", "D"}, {"B", "C"}, {"B", "E"}, {"D", "E"}, {"E", "F"}}
+	for i, e := range edges {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]), gograph.WithEdgeWeight(float64(i+1)))
+	}
+
+	return g
+}
+
+func TestBreadthFirstSearch(t *testing.T) {
+	g := newSearchGraph()
+
+	if _, err := BreadthFirstSearch(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	result, err := BreadthFirstSearch(g, "A")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if result.Start() != "A" {
+		t.Errorf("Expected start A, but got %s", result.Start())
+	}
+
+	if expected := []string{"A", "B", "D", "C", "E", "F"}; !reflect.DeepEqual(expected, result.Order()) {
+		t.Errorf("Expected order %v, but got %v", expected, result.Order())
+	}
+
+	depths := map[string]int{"A": 0, "B": 1, "D": 1, "C": 2, "E": 2, "F": 3}
+	for label, expected := range depths {
+		if depth, ok := result.Depth(label); !ok || depth != expected {
+			t.Errorf("Expected depth of %s to be %d, but got %d", label, expected, depth)
+		}
+	}
+
+	parents := map[string]string{"B": "A", "D": "A", "C": "B", "E": "B", "F": "E"}
+	for label, expected := range parents {
+		if parent, ok := result.Parent(label); !ok || parent != expected {
+			t.Errorf("Expected parent of %s to be %s, but got %s", label, expected, parent)
+		}
+	}
+
+	if _, ok := result.Parent("A"); ok {
+		t.Error("Expected the start vertex to have no parent")
+	}
+
+	if expected := []string{"C", "E"}; !reflect.DeepEqual(expected, result.AtDepth(2)) {
+		t.Errorf("Expected %v at depth 2, but got %v", expected, result.AtDepth(2))
+	}
+
+	tree := result.Tree()
+	if tree.Order() != 6 || tree.Size() != 5 || !tree.IsDirected() || !tree.IsWeighted() {
+		t.Errorf("Expected a directed weighted tree with 6 vertices and 5 edges, but got %d and %d", tree.Order(), tree.Size())
+	}
+
+	if tree.ContainsEdge(tree.GetVertexByID("D"), tree.GetVertexByID("E")) {
+		t.Error("Expected no edge D -> E in the BFS tree")
+	}
+
+	if e := tree.GetEdge(tree.GetVertexByID("E"), tree.GetVertexByID("F")); e == nil || e.Weight() != 6 {
+		t.Errorf("Expected edge E -> F with weight 6, but got %+v", e)
+	}
+}
+
+func TestBreadthFirstSearch_MaxDepth(t *testing.T) {
+	result, err := BreadthFirstSearch(newSearchGraph(), "A", WithMaxDepth[string](1))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if result.Visited("C") || !result.Visited("D") {
+		t.Errorf("Expected only the vertices up to depth 1, but got %v", result.Order())
+	}
+
+	if _, ok := result.Depth("C"); ok {
+		t.Error("Expected no depth for an unvisited vertex")
+	}
+}
+
+func TestDepthFirstSearch(t *testing.T) {
+	g := newSearchGraph()
+
+	if _, err := DepthFirstSearch(g, "X"); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	result, err := DepthFirstSearch(g, "A")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if expected := []string{"A", "D", "E", "F", "B", "C"}; !reflect.DeepEqual(expected, result.Order()) {
+		t.Errorf("Expected order %v, but got %v", expected, result.Order())
+	}
+
+	for _, label := range result.Order()[1:] {
+		parent, ok := result.Parent(label)
+		if !ok {
+			t.Fatalf("Expected a parent of %s", label)
+		}
+
+		depth, _ := result.Depth(label)
+		parentDepth, _ := result.Depth(parent)
+		if depth != parentDepth+1 {
+			t.Errorf("Expected depth of %s to be one more than its parent %s", label, parent)
+		}
+	}
+
+	if tree := result.Tree(); tree.Size() != 5 {
+		t.Errorf("Expected 5 tree edges, but got %d", tree.Size())
+	}
+}
+
+func TestKHopNeighborhood(t *testing.T) {
+	g := gograph.New[int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 4}, {1, 3}, {4, 5}} {
+		_, _ = g.AddEdge(gograph.NewVertex(e[0]), gograph.NewVertex(e[1]))
+	}
+
+	if _, err := KHopNeighborhood(g, 9, 1); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	neighborhood, err := KHopNeighborhood(g, 1, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if neighborhood.Order() != 3 || neighborhood.IsDirected() {
+		t.Errorf("Expected an undirected graph with 3 vertices, but got %d", neighborhood.Order())
+	}
+
+	// the edge 2 - 3 between the neighbors is included.
+	if !neighborhood.ContainsEdge(neighborhood.GetVertexByID(2), neighborhood.GetVertexByID(3)) {
+		t.Error("Expected edge 2 - 3 in the neighborhood")
+	}
+
+	neighborhood, _ = KHopNeighborhood(g, 1, 2)
+	if neighborhood.Order() != 4 || neighborhood.GetVertexByID(5) != nil {
+		t.Errorf("Expected vertices 1..4 in the 2-hop neighborhood, but got %d", neighborhood.Order())
+	}
+}
+
+func TestMultiSourceBreadthFirstSearch(t *testing.T) {
+	// a path 1 - 2 - 3 - 4 - 5 - 6 with the sources 1 and 5.
+	g := gograph.New[int]()
+	for i := 1; i < 6; i++ {
+		_, _ = g.AddEdge(gograph.NewVertex(i), gograph.NewVertex(i+1))
+	}
+
+	if _, err := MultiSourceBreadthFirstSearch(g, nil); !errors.Is(err, ErrNoSource) {
+		t.Errorf("Expected error %s, but got %v", ErrNoSource, err)
+	}
+
+	if _, err := MultiSourceBreadthFirstSearch(g, []int{1, 9}); !errors.Is(err, gograph.ErrVertexDoesNotExist) {
+		t.Errorf("Expected error %s, but got %v", gograph.ErrVertexDoesNotExist, err)
+	}
+
+	result, err := MultiSourceBreadthFirstSearch(g, []int{1, 5})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := map[int][2]int{1: {0, 1}, 2: {1, 1}, 3: {2, 1}, 4: {1, 5}, 5: {0, 5}, 6: {1, 5}}
+	for label, e := range expected {
+		depth, _ := result.Depth(label)
+		source, _ := result.Source(label)
+		if depth != e[0] || source != e[1] {
+			t.Errorf("Expected vertex %d at distance %d from %d, but got %d from %d", label, e[0], e[1], depth, source)
+		}
+	}
+
+	if expected := []int{1, 5, 2, 4, 6, 3}; !reflect.DeepEqual(expected, result.Order()) {
+		t.Errorf("Expected order %v, but got %v", expected, result.Order())
+	}
+
+	// the tree is a forest with a root for each source.
+	if tree := result.Tree(); tree.Order() != 6 || tree.Size() != 8 {
+		t.Errorf("Expected 6 vertices and 4 undirected edges, but got %d and %d", tree.Order(), tree.Size())
+	}
+
+	single, _ := BreadthFirstSearch(g, 3)
+	if source, ok := single.Source(6); !ok || source != 3 {
+		t.Errorf("Expected source 3, but got %d", source)
+	}
+}
+
+func TestNewMultiSourceBreadthFirstIterator(t *testing.T) {
+	g := gograph.New[int](gograph.Directed())
+	_, _ = g.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
+	_, _ = g.AddEdge(gograph.NewVertex(3), gograph.NewVertex(4))
+
+	if _, err := NewMultiSourceBreadthFirstIterator(g, []int{}); !errors.Is(err, ErrNoSource) {
+		t.Errorf("Expected error %s, but got %v", ErrNoSource, err)
+	}
+
+	iter, err := NewMultiSourceBreadthFirstIterator(g, []int{3, 1, 3})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var ordered []int
+	_ = iter.Iterate(func(v *gograph.Vertex[int]) error {
+		ordered = append(ordered, v.Label())
+		return nil
+	})
+
+	if expected := []int{3, 1, 4, 2}; !reflect.DeepEqual(expected, ordered) {
+		t.Errorf("Expected %v, but got %v", expected, ordered)
+	}
+}
+
+func TestBreadthFirstSearch_Direction(t *testing.T) {
+	g := newSearchGraph()
+
+	result, err := BreadthFirstSearch(g, "E", WithDirection[string](In))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if depth, _ := result.Depth("A"); depth != 2 {
+		t.Errorf("Expected depth 2, but got %d", depth)
+	}
+
+	tree := result.Tree()
+	if tree.Size() != 3 {
+		t.Errorf("Expected 3 edges in the tree, but got %d", tree.Size())
+	}
+
+	for _, e := range [][2]string{{"A", "B"}, {"B", "E"}, {"D", "E"}} {
+		if !tree.ContainsEdge(tree.GetVertexByID(e[0]), tree.GetVertexByID(e[1])) {
+			t.Errorf("Expected the tree edge %s -> %s", e[0], e[1])
+		}
+	}
+}
@@ -7,27 +7,42 @@ import (
 // topologicalIterator  is an implementation of the Iterator interface
 // for traversing a graph using a topological sort algorithm.
 type topologicalIterator[T comparable] struct {
-	graph gograph.Graph[T]     // the graph being traversed.
-	queue []*gograph.Vertex[T] // a slice that represents the queue of vertices to visit in topological order.
-	head  int                  // the current head of the queue.
+	graph   gograph.Graph[T]                // the graph being traversed.
+	queue   []*gograph.Vertex[T]            // a slice that represents the queue of vertices to visit in topological order.
+	head    int                             // the current head of the queue.
+	options []gograph.TopologyOptionFunc[T] // the tie-break options of the topological sort.
 }
 
 // NewTopologicalIterator creates a new instance of topologicalIterator
 // and returns it as the Iterator interface.
-func NewTopologicalIterator[T comparable](g gograph.Graph[T]) (Iterator[T], error) {
-	return newTopologicalIterator[T](g)
+//
+// The options of gograph.TopologySort, such as gograph.WithLabelOrder,
+// decide the order of the vertices that are ready at the same time. The
+// gograph.WithReversedEdges option walks the graph backwards along the
+// incoming edges, which is the In direction of the other iterators. The
+// Both direction doesn't apply, since a directed graph with any edge has
+// a cycle when its edges are followed in both directions.
+func NewTopologicalIterator[T comparable](
+	g gograph.Graph[T],
+	options ...gograph.TopologyOptionFunc[T],
+) (Iterator[T], error) {
+	return newTopologicalIterator[T](g, options...)
 }
 
-func newTopologicalIterator[T comparable](g gograph.Graph[T]) (*topologicalIterator[T], error) {
-	queue, err := gograph.TopologySort[T](g)
+func newTopologicalIterator[T comparable](
+	g gograph.Graph[T],
+	options ...gograph.TopologyOptionFunc[T],
+) (*topologicalIterator[T], error) {
+	queue, err := gograph.TopologySort[T](g, options...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &topologicalIterator[T]{
-		graph: g,
-		queue: queue,
-		head:  -1,
+		graph:   g,
+		queue:   queue,
+		head:    -1,
+		options: options,
 	}, nil
 }
 
@@ -69,7 +84,7 @@ func (t *topologicalIterator[T]) Reset() {
 	t.head = -1
 
 	var err error
-	t.queue, err = gograph.TopologySort[T](t.graph)
+	t.queue, err = gograph.TopologySort[T](t.graph, t.options...)
 	if err != nil {
 		panic(err)
 	}
@@ -2,6 +2,7 @@ package traverse
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/hmdsefi/gograph"
@@ -115,3 +116,53 @@ func TestTopologyOrderIterator_NotAcyclic(t *testing.T) {
 	_, _ = g2.AddEdge(gograph.NewVertex(1), gograph.NewVertex(2))
 	_, _ = g2.AddEdge(gograph.NewVertex(2), gograph.NewVertex(3))
 }
+
+func TestTopologyOrderIterator_Options(t *testing.T) {
+	g := gograph.New[int](gograph.Acyclic())
+	for _, label := range []int{5, 3, 4, 1, 2} {
+		g.AddVertexByLabel(label)
+	}
+
+	_, _ = g.AddEdge(g.GetVertexByID(3), g.GetVertexByID(2))
+	_, _ = g.AddEdge(g.GetVertexByID(5), g.GetVertexByID(1))
+
+	iterator, err := NewTopologicalIterator(g, gograph.WithLabelOrder(func(a, b int) int { return a - b }))
+	if err != nil {
+		t.Fatalf("Expect no error by calling NewTopologicalIterator, but got one, %s", err)
+	}
+
+	expected := []int{3, 2, 4, 5, 1}
+	for range 2 {
+		var order []int
+		_ = iterator.Iterate(func(v *gograph.Vertex[int]) error {
+			order = append(order, v.Label())
+			return nil
+		})
+
+		if !reflect.DeepEqual(expected, order) {
+			t.Errorf("Expected order %v, but got %v", expected, order)
+		}
+
+		iterator.Reset()
+	}
+}
+
+func TestTopologyOrderIterator_ReversedEdges(t *testing.T) {
+	g := newSearchGraph()
+
+	iterator, err := NewTopologicalIterator(g, gograph.WithReversedEdges[string]())
+	if err != nil {
+		t.Fatalf("Expect no error by calling NewTopologicalIterator, but got one, %s", err)
+	}
+
+	var order []string
+	_ = iterator.Iterate(func(v *gograph.Vertex[string]) error {
+		order = append(order, v.Label())
+		return nil
+	})
+
+	expected := []string{"C", "F", "E", "B", "D", "A"}
+	if !reflect.DeepEqual(expected, order) {
+		t.Errorf("Expected order %v, but got %v", expected, order)
+	}
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hmdsefi/gograph"
+)
+
+// CompareLabels compares two vertex labels and returns -1 if a is less
+// than b, 0 if they are equal, and +1 if a is greater than b.
+//
+// Labels of the ordered kinds (integers, floats and strings), including
+// named types such as "type ID int", are compared by their natural
+// order. Labels of different dynamic types, e.g. in a graph of "any"
+// labels, are ordered by their type names first. Any other comparable
+// type falls back to comparing the default string representation of the
+// labels, and then their Go-syntax representation, so distinct labels
+// that print the same still have a stable order.
+func CompareLabels[T comparable](a, b T) int {
+	if a == b {
+		return 0
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.IsValid() && vb.IsValid() && va.Type() == vb.Type() {
+		switch va.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			return cmp.Compare(va.String(), vb.String())
+		}
+	}
+
+	if c := cmp.Compare(fmt.Sprintf("%T", a), fmt.Sprintf("%T", b)); c != 0 {
+		return c
+	}
+
+	if c := cmp.Compare(fmt.Sprint(a), fmt.Sprint(b)); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+// SortLabels sorts the input labels in place, in the order defined
+// by CompareLabels.
+func SortLabels[T comparable](labels []T) {
+	sort.SliceStable(labels, func(i, j int) bool {
+		return CompareLabels(labels[i], labels[j]) < 0
+	})
+}
+
+// SortedVertices returns all the vertices of the graph sorted by their
+// labels, in the order defined by CompareLabels. Unlike GetAllVertices,
+// the output order doesn't change between calls.
+func SortedVertices[T comparable](g gograph.Graph[T]) []*gograph.Vertex[T] {
+	vertices := g.GetAllVertices()
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return CompareLabels(vertices[i].Label(), vertices[j].Label()) < 0
+	})
+
+	return vertices
+}
+
+// VertexText returns the display text of a vertex: its metadata if it is
+// a string or a fmt.Stringer, or else the default string representation
+// of its label.
+func VertexText[T comparable](v *gograph.Vertex[T]) string {
+	switch metadata := v.Metadata().(type) {
+	case string:
+		return metadata
+	case fmt.Stringer:
+		return metadata.String()
+	}
+
+	return fmt.Sprint(v.Label())
+}
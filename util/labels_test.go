@@ -0,0 +1,131 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hmdsefi/gograph"
+)
+
+type testLabel struct {
+	name string
+}
+
+func TestCompareLabels(t *testing.T) {
+	if CompareLabels(2, 10) >= 0 {
+		t.Errorf("Expected 2 to be less than 10")
+	}
+
+	if CompareLabels("b", "a") <= 0 {
+		t.Errorf("Expected b to be greater than a")
+	}
+
+	if CompareLabels(1.5, 1.5) != 0 {
+		t.Errorf("Expected 1.5 to be equal to 1.5")
+	}
+
+	if CompareLabels(testLabel{"x"}, testLabel{"y"}) >= 0 {
+		t.Errorf("Expected {x} to be less than {y}")
+	}
+
+	if CompareLabels(testLabel{"x"}, testLabel{"x"}) != 0 {
+		t.Errorf("Expected {x} to be equal to {x}")
+	}
+}
+
+type testID int
+
+type testName string
+
+type testPair struct {
+	first, second string
+}
+
+func TestCompareLabels_Named(t *testing.T) {
+	if CompareLabels(testID(9), testID(10)) >= 0 {
+		t.Errorf("Expected 9 to be less than 10")
+	}
+
+	if CompareLabels(uint8(200), uint8(30)) <= 0 {
+		t.Errorf("Expected 200 to be greater than 30")
+	}
+
+	if CompareLabels(float32(-1.5), float32(0.25)) >= 0 {
+		t.Errorf("Expected -1.5 to be less than 0.25")
+	}
+
+	if CompareLabels(testName("b"), testName("a")) <= 0 {
+		t.Errorf("Expected b to be greater than a")
+	}
+
+	ids := []testID{10, 9, 100, -1}
+	SortLabels(ids)
+	if expected := []testID{-1, 9, 10, 100}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected %v, but got %v", expected, ids)
+	}
+}
+
+func TestCompareLabels_Ties(t *testing.T) {
+	// both pairs print as "{a b c}"
+	a, b := testPair{"a b", "c"}, testPair{"a", "b c"}
+	if CompareLabels(a, b) == 0 || CompareLabels(a, b) != -CompareLabels(b, a) {
+		t.Errorf("Expected %#v and %#v to have a strict order", a, b)
+	}
+
+	var x, y any = 1, "1"
+	if CompareLabels(x, y) == 0 || CompareLabels(x, y) != -CompareLabels(y, x) {
+		t.Errorf("Expected %#v and %#v to have a strict order", x, y)
+	}
+
+	if CompareLabels[any](2, 10) >= 0 {
+		t.Errorf("Expected 2 to be less than 10")
+	}
+}
+
+func TestSortLabels(t *testing.T) {
+	labels := []int{10, 2, 33, 1}
+	SortLabels(labels)
+
+	expected := []int{1, 2, 10, 33}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected %v, but got %v", expected, labels)
+	}
+}
+
+func TestSortedVertices(t *testing.T) {
+	g := gograph.New[string]()
+	_, _ = g.AddEdge(gograph.NewVertex("C"), gograph.NewVertex("A"))
+	_, _ = g.AddEdge(gograph.NewVertex("B"), gograph.NewVertex("D"))
+
+	var labels []string
+	for _, v := range SortedVertices(g) {
+		labels = append(labels, v.Label())
+	}
+
+	expected := []string{"A", "B", "C", "D"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected %v, but got %v", expected, labels)
+	}
+}
+
+type city struct {
+	name string
+}
+
+func (c city) String() string {
+	return c.name
+}
+
+func TestVertexText(t *testing.T) {
+	g := gograph.New[int]()
+	v1 := g.AddVertexByLabel(1, gograph.WithVertexMetadata("one"))
+	v2 := g.AddVertexByLabel(2, gograph.WithVertexMetadata(city{name: "Berlin"}))
+	v3 := g.AddVertexByLabel(3, gograph.WithVertexMetadata(3.5))
+
+	tests := map[*gograph.Vertex[int]]string{v1: "one", v2: "Berlin", v3: "3"}
+	for v, expected := range tests {
+		if text := VertexText(v); text != expected {
+			t.Errorf("Expected text %q, but got %q", expected, text)
+		}
+	}
+}